@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 	"net"
+	"net/http"
 	"sync"
 
 	"github.com/8thgencore/microservice-chat/internal/app/provider"
@@ -14,12 +15,18 @@ import (
 	"google.golang.org/grpc"
 )
 
+// worker is a background job running until the context is cancelled.
+type worker interface {
+	Run(ctx context.Context)
+}
+
 // App structure contains main application structures.
 type App struct {
 	cfg *config.Config
 
-	serviceProvider *provider.ServiceProvider
-	grpcServer      *grpc.Server
+	serviceProvider  *provider.ServiceProvider
+	grpcServer       *grpc.Server
+	prometheusServer *http.Server
 }
 
 // NewApp creates new App object.
@@ -38,8 +45,11 @@ func (a *App) Run() error {
 		closer.Wait()
 	}()
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	wg := sync.WaitGroup{}
-	wg.Add(1) // gRPC servers
+	wg.Add(3) // gRPC and Prometheus servers, background workers
 
 	go func() {
 		defer wg.Done()
@@ -49,11 +59,50 @@ func (a *App) Run() error {
 		}
 	}()
 
+	go func() {
+		defer wg.Done()
+
+		if err := a.runPrometheusServer(); err != nil {
+			log.Fatal("failed to run Prometheus server: ", error.Error(err))
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		a.runWorkers(ctx)
+	}()
+
 	wg.Wait()
 
 	return nil
 }
 
+func (a *App) runWorkers(ctx context.Context) {
+	workers := []worker{
+		a.serviceProvider.SchedulerWorker(ctx),
+		a.serviceProvider.ReaperWorker(ctx),
+		a.serviceProvider.PurgerWorker(ctx),
+		a.serviceProvider.RekeyerWorker(ctx),
+		a.serviceProvider.RateLimiter(),
+	}
+
+	logger.Info("background workers running", zap.Int("count", len(workers)))
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(workers))
+
+	for _, w := range workers {
+		go func() {
+			defer wg.Done()
+
+			w.Run(ctx)
+		}()
+	}
+
+	wg.Wait()
+}
+
 func (a *App) runGrpcServer() error {
 	cfg := a.serviceProvider.Config.GRPC
 
@@ -71,3 +120,13 @@ func (a *App) runGrpcServer() error {
 
 	return nil
 }
+
+func (a *App) runPrometheusServer() error {
+	logger.Info("Prometheus server running on ", zap.String("address", a.cfg.Prometheus.Address()))
+
+	if err := a.prometheusServer.ListenAndServe(); err != nil {
+		return err
+	}
+
+	return nil
+}
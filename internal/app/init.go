@@ -3,13 +3,17 @@ package app
 import (
 	"context"
 	"log"
+	"net/http"
+	"time"
 
 	"github.com/8thgencore/microservice-chat/internal/app/provider"
 	"github.com/8thgencore/microservice-chat/internal/app/security"
 	"github.com/8thgencore/microservice-chat/internal/config"
 	"github.com/8thgencore/microservice-chat/internal/interceptor"
+	"github.com/8thgencore/microservice-chat/internal/metrics"
 	chatv1 "github.com/8thgencore/microservice-chat/pkg/chat/v1"
 	"github.com/8thgencore/microservice-common/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
@@ -18,6 +22,7 @@ func (a *App) initDeps(ctx context.Context) error {
 	inits := []func(context.Context) error{
 		a.initConfig,
 		a.initLogger,
+		a.initPrometheusServer,
 		a.initServiceProvider,
 		a.initGRPCServer,
 	}
@@ -47,6 +52,24 @@ func (a *App) initLogger(_ context.Context) error {
 	return nil
 }
 
+func (a *App) initPrometheusServer(ctx context.Context) error {
+	err := metrics.Init(ctx)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	a.prometheusServer = &http.Server{
+		Addr:              a.cfg.Prometheus.Address(),
+		Handler:           mux,
+		ReadHeaderTimeout: 15 * time.Second,
+	}
+
+	return nil
+}
+
 func (a *App) initServiceProvider(_ context.Context) error {
 	a.serviceProvider = provider.NewServiceProvider(a.cfg)
 	return nil
@@ -54,18 +77,28 @@ func (a *App) initServiceProvider(_ context.Context) error {
 
 func (a *App) initGRPCServer(ctx context.Context) error {
 	// Setup credentials
-	creds, err := security.LoadServerCredentials(a.cfg.TLS.CertPath, a.cfg.TLS.KeyPath)
+	creds, err := security.LoadServerCredentials(a.cfg.TLS.CertPath, a.cfg.TLS.KeyPath, a.cfg.TLS.ClientCAPath)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	c := a.serviceProvider.InterceptorClient()
+	c := a.serviceProvider.InterceptorClient(ctx)
+	l := a.serviceProvider.RateLimiter()
 	a.grpcServer = grpc.NewServer(
 		grpc.Creds(creds),
 		grpc.ChainUnaryInterceptor(
+			interceptor.RequestIDInterceptor,
 			interceptor.LogInterceptor,
 			interceptor.ValidateInterceptor,
 			c.PolicyInterceptor,
+			l.RateLimitInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			interceptor.RequestIDStreamInterceptor,
+			interceptor.LogStreamInterceptor,
+			interceptor.ValidateStreamInterceptor,
+			c.PolicyStreamInterceptor,
+			l.RateLimitStreamInterceptor,
 		),
 	)
 
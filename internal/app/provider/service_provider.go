@@ -2,25 +2,48 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"log"
 
 	"github.com/8thgencore/microservice-chat/internal/app/security"
 	"github.com/8thgencore/microservice-chat/internal/client/rpc"
 	"github.com/8thgencore/microservice-chat/internal/config"
 	"github.com/8thgencore/microservice-chat/internal/delivery/chat"
+	"github.com/8thgencore/microservice-chat/internal/filter"
 	"github.com/8thgencore/microservice-chat/internal/interceptor"
 	"github.com/8thgencore/microservice-chat/internal/repository"
 	"github.com/8thgencore/microservice-chat/internal/service"
+	"github.com/8thgencore/microservice-chat/internal/spam"
+	"github.com/8thgencore/microservice-chat/internal/worker/purger"
+	"github.com/8thgencore/microservice-chat/internal/worker/reaper"
+	"github.com/8thgencore/microservice-chat/internal/worker/rekeyer"
+	"github.com/8thgencore/microservice-chat/internal/worker/scheduler"
+	"github.com/8thgencore/microservice-common/pkg/closer"
 	"github.com/8thgencore/microservice-common/pkg/db"
+	"github.com/8thgencore/microservice-common/pkg/logger"
+	goredis "github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
 	accessv1 "github.com/8thgencore/microservice-auth/pkg/pb/access/v1"
 	rpcAuth "github.com/8thgencore/microservice-chat/internal/client/rpc/auth"
 
+	blocksRepository "github.com/8thgencore/microservice-chat/internal/repository/blocks"
+	botsRepository "github.com/8thgencore/microservice-chat/internal/repository/bots"
 	chatRepository "github.com/8thgencore/microservice-chat/internal/repository/chat"
+	devicesRepository "github.com/8thgencore/microservice-chat/internal/repository/devices"
+	flagsRepository "github.com/8thgencore/microservice-chat/internal/repository/flags"
 	logRepository "github.com/8thgencore/microservice-chat/internal/repository/log"
+	membersRepository "github.com/8thgencore/microservice-chat/internal/repository/members"
 	messagesRepository "github.com/8thgencore/microservice-chat/internal/repository/messages"
+	payloadsRepository "github.com/8thgencore/microservice-chat/internal/repository/payloads"
+	reportsRepository "github.com/8thgencore/microservice-chat/internal/repository/reports"
+	restrictionsRepository "github.com/8thgencore/microservice-chat/internal/repository/restrictions"
+	scheduledRepository "github.com/8thgencore/microservice-chat/internal/repository/scheduled"
+	auditService "github.com/8thgencore/microservice-chat/internal/service/audit"
+	botService "github.com/8thgencore/microservice-chat/internal/service/bot"
 	chatService "github.com/8thgencore/microservice-chat/internal/service/chat"
+	deviceService "github.com/8thgencore/microservice-chat/internal/service/device"
 )
 
 // ServiceProvider is a struct that provides access to various services and repositories.
@@ -33,14 +56,36 @@ type ServiceProvider struct {
 	authClient rpc.AuthClient
 
 	interceptorClient *interceptor.Client
+	rateLimiter       *interceptor.RateLimiter
 
-	chatRepository     repository.ChatRepository
-	messagesRepository repository.MessagesRepository
-	logRepository      repository.LogRepository
+	chatRepository         repository.ChatRepository
+	membersRepository      repository.MembersRepository
+	restrictionsRepository repository.RestrictionsRepository
+	textCipher             *messagesRepository.TextCipher
+	messagesRepository     repository.MessagesRepository
+	logRepository          repository.LogRepository
+	scheduledRepository    repository.ScheduledRepository
+	botsRepository         repository.BotsRepository
+	flagsRepository        repository.FlagsRepository
+	reportsRepository      repository.ReportsRepository
+	blocksRepository       repository.BlocksRepository
+	devicesRepository      repository.DevicesRepository
+	payloadsRepository     repository.PayloadsRepository
 
-	chatService service.ChatService
+	messageFilter filter.Pipeline
+	spamDetector  *spam.Detector
+
+	chatService   service.ChatService
+	botService    service.BotService
+	auditService  service.AuditService
+	deviceService service.DeviceService
 
 	chatImpl *chat.Implementation
+
+	schedulerWorker *scheduler.Worker
+	reaperWorker    *reaper.Worker
+	purgerWorker    *purger.Worker
+	rekeyerWorker   *rekeyer.Worker
 }
 
 // NewServiceProvider creates a new instance of ServiceProvider with the given configuration.
@@ -59,8 +104,55 @@ func (s *ServiceProvider) AuthClient() rpc.AuthClient {
 		return s.authClient
 	}
 
+	if cfg.Offline {
+		s.authClient = s.offlineAuthClient()
+	} else {
+		s.authClient = s.remoteAuthClient()
+	}
+
+	if cfg.FailOpen {
+		keys, err := rpcAuth.LoadKeySet(cfg.PublicKeysPath)
+		if err != nil {
+			log.Fatalf("failed to load auth public keys: %v", err)
+		}
+
+		s.authClient = rpcAuth.NewFailOpenAuthClient(s.authClient, keys, cfg.Issuer, cfg.ReadOnlyEndpoints)
+	}
+
+	return s.authClient
+}
+
+// offlineAuthClient creates AuthClient which verifies tokens locally.
+func (s *ServiceProvider) offlineAuthClient() rpc.AuthClient {
+	cfg := s.Config.AuthClient
+
+	keys, err := rpcAuth.LoadKeySet(cfg.PublicKeysPath)
+	if err != nil {
+		log.Fatalf("failed to load auth public keys: %v", err)
+	}
+
+	var policy rpcAuth.Policy
+	if cfg.PolicyPath != "" {
+		policy, err = rpcAuth.LoadPolicy(cfg.PolicyPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	var fallback rpc.AuthClient
+	if cfg.RemoteFallback {
+		fallback = s.remoteAuthClient()
+	}
+
+	return rpcAuth.NewOfflineAuthClient(keys, policy, cfg.Issuer, fallback)
+}
+
+// remoteAuthClient creates AuthClient which calls the auth service.
+func (s *ServiceProvider) remoteAuthClient() rpc.AuthClient {
+	cfg := s.Config.AuthClient
+
 	// Setup credentials
-	creds, err := security.LoadClientCredentials(cfg.CertPath)
+	creds, err := security.LoadClientCredentials(cfg.CertPath, cfg.ClientCertPath, cfg.ClientKeyPath)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -74,23 +166,38 @@ func (s *ServiceProvider) AuthClient() rpc.AuthClient {
 		log.Fatalf("failed to connect to authentication service: %v", err)
 	}
 
-	// Initialize the auth client
-	s.authClient = rpcAuth.NewAuthClient(accessv1.NewAccessV1Client(conn))
+	// Only decisions of the auth service are cached, failures and fail-open results are not.
+	client := rpcAuth.NewAuthClient(accessv1.NewAccessV1Client(conn))
+	client = rpcAuth.NewResilientAuthClient(client, cfg)
+	if cfg.CacheTTL > 0 {
+		client = rpcAuth.NewCachedAuthClient(client, cfg.CacheTTL, cfg.CacheSize)
+	}
 
-	return s.authClient
+	return client
 }
 
 // InterceptorClient returns an instance of interceptor.Client.
-func (s *ServiceProvider) InterceptorClient() *interceptor.Client {
+func (s *ServiceProvider) InterceptorClient(ctx context.Context) *interceptor.Client {
 	if s.interceptorClient == nil {
 		s.interceptorClient = &interceptor.Client{
-			Client: s.AuthClient(),
+			Client:       s.AuthClient(),
+			Bots:         s.BotService(ctx),
+			BotEndpoints: s.Config.Bot.Endpoints,
 		}
 	}
 
 	return s.interceptorClient
 }
 
+// RateLimiter returns an instance of interceptor.RateLimiter.
+func (s *ServiceProvider) RateLimiter() *interceptor.RateLimiter {
+	if s.rateLimiter == nil {
+		s.rateLimiter = interceptor.NewRateLimiter(s.Config.RateLimit)
+	}
+
+	return s.rateLimiter
+}
+
 // ChatRepository returns a chat repository.
 func (s *ServiceProvider) ChatRepository(ctx context.Context) repository.ChatRepository {
 	if s.chatRepository == nil {
@@ -99,10 +206,46 @@ func (s *ServiceProvider) ChatRepository(ctx context.Context) repository.ChatRep
 	return s.chatRepository
 }
 
+// MembersRepository returns a chat members repository.
+func (s *ServiceProvider) MembersRepository(ctx context.Context) repository.MembersRepository {
+	if s.membersRepository == nil {
+		s.membersRepository = membersRepository.NewRepository(s.DatabaseClient(ctx))
+	}
+	return s.membersRepository
+}
+
+// RestrictionsRepository returns a chat bans and mutes repository.
+func (s *ServiceProvider) RestrictionsRepository(ctx context.Context) repository.RestrictionsRepository {
+	if s.restrictionsRepository == nil {
+		s.restrictionsRepository = restrictionsRepository.NewRepository(s.DatabaseClient(ctx))
+	}
+	return s.restrictionsRepository
+}
+
+// TextCipher returns the cipher of message texts stored at rest.
+func (s *ServiceProvider) TextCipher(ctx context.Context) *messagesRepository.TextCipher {
+	if s.textCipher == nil {
+		var keyring *messagesRepository.Keyring
+		if path := s.Config.Encryption.KeyFile; path != "" {
+			var err error
+			keyring, err = messagesRepository.LoadKeyring(path)
+			if errors.Is(err, messagesRepository.ErrKeyFileMissing) {
+				log.Fatalf("%v: create it with a base64 encoded 32 byte key or unset ENCRYPTION_KEY_FILE", err)
+			}
+			if err != nil {
+				log.Fatalf("failed to load message encryption keys: %v", err)
+			}
+		}
+
+		s.textCipher = messagesRepository.NewTextCipher(s.DatabaseClient(ctx), keyring)
+	}
+	return s.textCipher
+}
+
 // MessagesRepository returns a message repository.
 func (s *ServiceProvider) MessagesRepository(ctx context.Context) repository.MessagesRepository {
 	if s.messagesRepository == nil {
-		s.messagesRepository = messagesRepository.NewRepository(s.DatabaseClient(ctx))
+		s.messagesRepository = messagesRepository.NewRepository(s.DatabaseClient(ctx), s.TextCipher(ctx))
 	}
 	return s.messagesRepository
 }
@@ -115,24 +258,220 @@ func (s *ServiceProvider) LogRepository(ctx context.Context) repository.LogRepos
 	return s.logRepository
 }
 
+// ScheduledRepository returns a scheduled messages repository.
+func (s *ServiceProvider) ScheduledRepository(ctx context.Context) repository.ScheduledRepository {
+	if s.scheduledRepository == nil {
+		s.scheduledRepository = scheduledRepository.NewRepository(s.DatabaseClient(ctx), s.TextCipher(ctx))
+	}
+	return s.scheduledRepository
+}
+
+// BotsRepository returns a bot accounts repository.
+func (s *ServiceProvider) BotsRepository(ctx context.Context) repository.BotsRepository {
+	if s.botsRepository == nil {
+		s.botsRepository = botsRepository.NewRepository(s.DatabaseClient(ctx))
+	}
+	return s.botsRepository
+}
+
+// FlagsRepository returns a repository of messages flagged for review.
+func (s *ServiceProvider) FlagsRepository(ctx context.Context) repository.FlagsRepository {
+	if s.flagsRepository == nil {
+		s.flagsRepository = flagsRepository.NewRepository(s.DatabaseClient(ctx))
+	}
+	return s.flagsRepository
+}
+
+// ReportsRepository returns a reported messages repository.
+func (s *ServiceProvider) ReportsRepository(ctx context.Context) repository.ReportsRepository {
+	if s.reportsRepository == nil {
+		s.reportsRepository = reportsRepository.NewRepository(s.DatabaseClient(ctx), s.TextCipher(ctx))
+	}
+	return s.reportsRepository
+}
+
+// BlocksRepository returns a user blocks repository.
+func (s *ServiceProvider) BlocksRepository(ctx context.Context) repository.BlocksRepository {
+	if s.blocksRepository == nil {
+		s.blocksRepository = blocksRepository.NewRepository(s.DatabaseClient(ctx))
+	}
+	return s.blocksRepository
+}
+
+// DevicesRepository returns a device public keys repository.
+func (s *ServiceProvider) DevicesRepository(ctx context.Context) repository.DevicesRepository {
+	if s.devicesRepository == nil {
+		s.devicesRepository = devicesRepository.NewRepository(s.DatabaseClient(ctx))
+	}
+	return s.devicesRepository
+}
+
+// PayloadsRepository returns an end-to-end encrypted message payloads repository.
+func (s *ServiceProvider) PayloadsRepository(ctx context.Context) repository.PayloadsRepository {
+	if s.payloadsRepository == nil {
+		s.payloadsRepository = payloadsRepository.NewRepository(s.DatabaseClient(ctx))
+	}
+	return s.payloadsRepository
+}
+
+// MessageFilter returns filters applied to sent messages.
+func (s *ServiceProvider) MessageFilter() filter.Pipeline {
+	if s.messageFilter == nil {
+		path := s.Config.Chat.FilterPath
+		if path == "" {
+			s.messageFilter = filter.Chain(nil)
+			return s.messageFilter
+		}
+
+		reloader, err := filter.NewReloader(path)
+		if err != nil {
+			log.Fatalf("failed to load message filters: %v", err)
+		}
+		s.messageFilter = reloader
+	}
+	return s.messageFilter
+}
+
+// SpamDetector returns a detector of floods and repeated messages.
+// The Redis client is checked at start and closed when the application shuts down.
+func (s *ServiceProvider) SpamDetector(ctx context.Context) *spam.Detector {
+	if s.spamDetector == nil {
+		cfg := s.Config.Spam
+
+		store := spam.NewMemoryStore()
+		if cfg.RedisAddress != "" {
+			client := goredis.NewClient(&goredis.Options{
+				Addr:     cfg.RedisAddress,
+				Password: cfg.RedisPassword,
+				DB:       cfg.RedisDB,
+			})
+
+			err := client.Ping(ctx).Err()
+			if err != nil {
+				log.Fatalf("failed to ping spam redis: %v", err)
+			}
+
+			closer.Add(client.Close)
+
+			store = spam.NewRedisStore(client)
+		}
+
+		detector, err := spam.NewDetector(cfg, store)
+		if err != nil {
+			log.Fatal(err)
+		}
+		s.spamDetector = detector
+	}
+	return s.spamDetector
+}
+
 // ChatService returns a chat service.
+// Channels for chats created before the start are initialized right away.
 func (s *ServiceProvider) ChatService(ctx context.Context) service.ChatService {
 	if s.chatService == nil {
 		s.chatService = chatService.NewService(
 			s.ChatRepository(ctx),
+			s.MembersRepository(ctx),
+			s.RestrictionsRepository(ctx),
 			s.MessagesRepository(ctx),
 			s.LogRepository(ctx),
+			s.ScheduledRepository(ctx),
+			s.FlagsRepository(ctx),
+			s.ReportsRepository(ctx),
+			s.BlocksRepository(ctx),
+			s.PayloadsRepository(ctx),
+			s.DevicesRepository(ctx),
 			s.TxManager(ctx),
+			s.MessageFilter(),
+			s.SpamDetector(ctx),
+			s.Config.Chat.CacheTTL,
 		)
+
+		if err := s.chatService.InitChannels(ctx); err != nil {
+			logger.Fatal("failed to init chat channels: ", zap.Error(err))
+		}
 	}
 
 	return s.chatService
 }
 
+// BotService returns a bot accounts service.
+func (s *ServiceProvider) BotService(ctx context.Context) service.BotService {
+	if s.botService == nil {
+		s.botService = botService.NewService(
+			s.BotsRepository(ctx),
+			s.LogRepository(ctx),
+			s.TxManager(ctx),
+		)
+	}
+	return s.botService
+}
+
+// AuditService returns an audit log service.
+func (s *ServiceProvider) AuditService(ctx context.Context) service.AuditService {
+	if s.auditService == nil {
+		s.auditService = auditService.NewService(s.LogRepository(ctx))
+	}
+	return s.auditService
+}
+
+// DeviceService returns a device keys service.
+func (s *ServiceProvider) DeviceService(ctx context.Context) service.DeviceService {
+	if s.deviceService == nil {
+		s.deviceService = deviceService.NewService(
+			s.DevicesRepository(ctx),
+			s.LogRepository(ctx),
+			s.TxManager(ctx),
+		)
+	}
+	return s.deviceService
+}
+
 // ChatImpl returns a chat api implementation.
 func (s *ServiceProvider) ChatImpl(ctx context.Context) *chat.Implementation {
 	if s.chatImpl == nil {
-		s.chatImpl = chat.NewImplementation(s.ChatService(ctx))
+		s.chatImpl = chat.NewImplementation(
+			s.ChatService(ctx),
+			s.BotService(ctx),
+			s.AuditService(ctx),
+			s.DeviceService(ctx),
+		)
 	}
 	return s.chatImpl
 }
+
+// SchedulerWorker returns a worker delivering scheduled messages.
+func (s *ServiceProvider) SchedulerWorker(ctx context.Context) *scheduler.Worker {
+	if s.schedulerWorker == nil {
+		s.schedulerWorker = scheduler.NewWorker(
+			s.Config.Scheduler,
+			s.ScheduledRepository(ctx),
+			s.ChatService(ctx),
+		)
+	}
+	return s.schedulerWorker
+}
+
+// ReaperWorker returns a worker deleting expired messages.
+func (s *ServiceProvider) ReaperWorker(ctx context.Context) *reaper.Worker {
+	if s.reaperWorker == nil {
+		s.reaperWorker = reaper.NewWorker(s.Config.Reaper, s.ChatService(ctx))
+	}
+	return s.reaperWorker
+}
+
+// PurgerWorker returns a worker deleting messages outside of chat retention.
+func (s *ServiceProvider) PurgerWorker(ctx context.Context) *purger.Worker {
+	if s.purgerWorker == nil {
+		s.purgerWorker = purger.NewWorker(s.Config.Retention, s.ChatService(ctx))
+	}
+	return s.purgerWorker
+}
+
+// RekeyerWorker returns a worker rotating chat keys and re-encrypting messages.
+func (s *ServiceProvider) RekeyerWorker(ctx context.Context) *rekeyer.Worker {
+	if s.rekeyerWorker == nil {
+		s.rekeyerWorker = rekeyer.NewWorker(s.Config.Encryption, s.MessagesRepository(ctx))
+	}
+	return s.rekeyerWorker
+}
@@ -0,0 +1,109 @@
+package security
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync/atomic"
+
+	"github.com/8thgencore/microservice-chat/internal/filewatch"
+	"github.com/8thgencore/microservice-common/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// reloader keeps a key pair and a CA pool loaded from files and reloads them when the files change.
+type reloader struct {
+	certPath string
+	keyPath  string
+	caPath   string
+
+	cert atomic.Pointer[tls.Certificate]
+	pool atomic.Pointer[x509.CertPool]
+}
+
+func newReloader(certPath, keyPath, caPath string) (*reloader, error) {
+	r := &reloader{
+		certPath: certPath,
+		keyPath:  keyPath,
+		caPath:   caPath,
+	}
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	if err := r.watch(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *reloader) load() error {
+	if r.certPath != "" && r.keyPath != "" {
+		cert, err := tls.LoadX509KeyPair(r.certPath, r.keyPath)
+		if err != nil {
+			return fmt.Errorf("failed to load key pair: %w", err)
+		}
+		r.cert.Store(&cert)
+	}
+
+	if r.caPath != "" {
+		data, err := os.ReadFile(r.caPath)
+		if err != nil {
+			return fmt.Errorf("failed to read CA certificates: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return fmt.Errorf("no CA certificates found in %s", r.caPath)
+		}
+		r.pool.Store(pool)
+	}
+
+	return nil
+}
+
+func (r *reloader) watch() error {
+	return filewatch.Watch([]string{r.certPath, r.keyPath, r.caPath}, func() {
+		// A certificate and its key are not replaced at once,
+		// so a failed load keeps the previous pair until the next change.
+		if err := r.load(); err != nil {
+			logger.Warn("failed to reload certificates", zap.Error(err))
+			return
+		}
+		logger.Debug("certificates reloaded")
+	})
+}
+
+func (r *reloader) certificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return r.cert.Load(), nil
+}
+
+func (r *reloader) clientCertificate(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	if cert := r.cert.Load(); cert != nil {
+		return cert, nil
+	}
+
+	// No certificate is sent when the client has none configured
+	return &tls.Certificate{}, nil
+}
+
+// verifyServer verifies the server chain against the current CA pool.
+func (r *reloader) verifyServer(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("server provided no certificate")
+	}
+
+	opts := x509.VerifyOptions{
+		Roots:         r.pool.Load(),
+		DNSName:       cs.ServerName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	_, err := cs.PeerCertificates[0].Verify(opts)
+	return err
+}
@@ -2,6 +2,7 @@
 package security
 
 import (
+	"crypto/tls"
 	"fmt"
 
 	"google.golang.org/grpc/credentials"
@@ -9,13 +10,22 @@ import (
 )
 
 // LoadClientCredentials sets up and returns the appropriate transport credentials for the client.
-func LoadClientCredentials(certPath string) (credentials.TransportCredentials, error) {
-	if certPath != "" {
-		creds, err := credentials.NewClientTLSFromFile(certPath, "")
+// caPath verifies the server, certPath and keyPath are the optional client certificate for mutual TLS.
+// The files are reloaded when they change.
+func LoadClientCredentials(caPath, certPath, keyPath string) (credentials.TransportCredentials, error) {
+	if caPath != "" {
+		r, err := newReloader(certPath, keyPath, caPath)
 		if err != nil {
 			return nil, fmt.Errorf("failed to load client TLS credentials: %w", err)
 		}
-		return creds, nil
+
+		return credentials.NewTLS(&tls.Config{
+			MinVersion:           tls.VersionTLS12,
+			GetClientCertificate: r.clientCertificate,
+			// The chain is verified in VerifyConnection against the reloadable CA pool
+			InsecureSkipVerify: true, //nolint:gosec
+			VerifyConnection:   r.verifyServer,
+		}), nil
 	}
 
 	// If no certificate is provided, use insecure credentials (for development or non-production)
@@ -23,13 +33,32 @@ func LoadClientCredentials(certPath string) (credentials.TransportCredentials, e
 }
 
 // LoadServerCredentials sets up and returns the appropriate transport credentials for the server.
-func LoadServerCredentials(certPath, keyPath string) (credentials.TransportCredentials, error) {
+// If clientCAPath is set, clients must present a certificate signed by it.
+// The files are reloaded when they change.
+func LoadServerCredentials(certPath, keyPath, clientCAPath string) (credentials.TransportCredentials, error) {
 	if certPath != "" {
-		creds, err := credentials.NewServerTLSFromFile(certPath, keyPath)
+		r, err := newReloader(certPath, keyPath, clientCAPath)
 		if err != nil {
 			return nil, fmt.Errorf("failed to load server TLS credentials: %w", err)
 		}
-		return creds, nil
+
+		cfg := &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: r.certificate,
+		}
+		if clientCAPath != "" {
+			cfg.GetConfigForClient = func(_ *tls.ClientHelloInfo) (*tls.Config, error) {
+				return &tls.Config{
+					MinVersion:     tls.VersionTLS12,
+					NextProtos:     []string{"h2"},
+					GetCertificate: r.certificate,
+					ClientAuth:     tls.RequireAndVerifyClientCert,
+					ClientCAs:      r.pool.Load(),
+				}, nil
+			}
+		}
+
+		return credentials.NewTLS(cfg), nil
 	}
 
 	// If no certificate is provided, use insecure credentials (for development or non-production)
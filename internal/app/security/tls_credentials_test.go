@@ -0,0 +1,257 @@
+package security
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/8thgencore/microservice-common/pkg/closer"
+	"github.com/8thgencore/microservice-common/pkg/logger"
+	"google.golang.org/grpc/credentials"
+)
+
+func TestMain(m *testing.M) {
+	logger.Init("test")
+	code := m.Run()
+	closer.CloseAll()
+	os.Exit(code)
+}
+
+// testCA issues certificates for the tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	t.Helper()
+
+	key := generateKey(t)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns PEM encoded certificate and key for the name.
+func (ca *testCA) issue(t *testing.T, name string, usage x509.ExtKeyUsage) (certPEM, keyPEM []byte) {
+	t.Helper()
+
+	key := generateKey(t)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+}
+
+func generateKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return key
+}
+
+// testFiles are the certificate files of one side of the connection.
+type testFiles struct {
+	cert, key, ca string
+}
+
+func newTestFiles(t *testing.T) testFiles {
+	dir := t.TempDir()
+
+	return testFiles{
+		cert: filepath.Join(dir, "cert.pem"),
+		key:  filepath.Join(dir, "key.pem"),
+		ca:   filepath.Join(dir, "ca.pem"),
+	}
+}
+
+// write stores the certificate issued by ca for name and trusts the trusted CA.
+func (f testFiles) write(t *testing.T, ca *testCA, name string, usage x509.ExtKeyUsage, trusted *testCA) {
+	t.Helper()
+
+	certPEM, keyPEM := ca.issue(t, name, usage)
+	for path, data := range map[string][]byte{f.cert: certPEM, f.key: keyPEM, f.ca: trusted.pem} {
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// handshake connects the client to the server and returns the error of either side.
+func handshake(t *testing.T, server, client credentials.TransportCredentials) error {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+
+		_, _, err = server.ServerHandshake(conn)
+		serverErr <- err
+	}()
+
+	conn, err := net.Dial("tcp", lis.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, _, err = client.ClientHandshake(ctx, "localhost", conn)
+
+	return errors.Join(err, <-serverErr)
+}
+
+func TestMutualTLS(t *testing.T) {
+	ca := newTestCA(t, "ca")
+	other := newTestCA(t, "other")
+
+	serverFiles := newTestFiles(t)
+	serverFiles.write(t, ca, "localhost", x509.ExtKeyUsageServerAuth, ca)
+	server, err := LoadServerCredentials(serverFiles.cert, serverFiles.key, serverFiles.ca)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		// issuer signs the client certificate, nil sends no certificate
+		issuer *testCA
+		// trusted is the CA the client verifies the server with
+		trusted *testCA
+		wantErr bool
+	}{
+		{name: "certificate of the CA", issuer: ca, trusted: ca},
+		{name: "no client certificate", trusted: ca, wantErr: true},
+		{name: "certificate of another CA", issuer: other, trusted: ca, wantErr: true},
+		{name: "server of another CA", issuer: ca, trusted: other, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := newTestFiles(t)
+			var certPath, keyPath string
+			if tt.issuer != nil {
+				files.write(t, tt.issuer, "client", x509.ExtKeyUsageClientAuth, tt.trusted)
+				certPath, keyPath = files.cert, files.key
+			} else if err := os.WriteFile(files.ca, tt.trusted.pem, 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			client, err := LoadClientCredentials(files.ca, certPath, keyPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err = handshake(t, server, client); (err != nil) != tt.wantErr {
+				t.Errorf("handshake error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMutualTLSReload(t *testing.T) {
+	oldCA := newTestCA(t, "old")
+	newCA := newTestCA(t, "new")
+
+	serverFiles := newTestFiles(t)
+	serverFiles.write(t, oldCA, "localhost", x509.ExtKeyUsageServerAuth, oldCA)
+	server, err := LoadServerCredentials(serverFiles.cert, serverFiles.key, serverFiles.ca)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientFiles := newTestFiles(t)
+	clientFiles.write(t, oldCA, "client", x509.ExtKeyUsageClientAuth, oldCA)
+	client, err := LoadClientCredentials(clientFiles.ca, clientFiles.cert, clientFiles.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = handshake(t, server, client); err != nil {
+		t.Fatalf("handshake before rotation: error = %v", err)
+	}
+
+	// Both sides move to the new CA without being recreated
+	serverFiles.write(t, newCA, "localhost", x509.ExtKeyUsageServerAuth, newCA)
+	clientFiles.write(t, newCA, "client", x509.ExtKeyUsageClientAuth, newCA)
+
+	staleFiles := newTestFiles(t)
+	staleFiles.write(t, oldCA, "client", x509.ExtKeyUsageClientAuth, oldCA)
+	stale, err := LoadClientCredentials(staleFiles.ca, staleFiles.cert, staleFiles.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		err = handshake(t, server, client)
+		staleErr := handshake(t, server, stale)
+		if err == nil && staleErr != nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("certificates are not reloaded: handshake error = %v, with old certificates = %v", err, staleErr)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
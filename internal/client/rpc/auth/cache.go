@@ -0,0 +1,185 @@
+package auth
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/client/rpc"
+	"github.com/8thgencore/microservice-chat/internal/metrics"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authMetadataHeader = "authorization"
+	authPrefix         = "Bearer "
+
+	cacheHit    = "hit"
+	cacheMiss   = "miss"
+	cacheShared = "shared"
+)
+
+type cacheKey struct {
+	token    [sha256.Size]byte
+	endpoint string
+}
+
+type cacheEntry struct {
+	key       cacheKey
+	err       error
+	expiresAt time.Time
+}
+
+// cachedClient memoizes access decisions of the wrapped client per token and endpoint.
+type cachedClient struct {
+	client rpc.AuthClient
+	ttl    time.Duration
+	size   int
+
+	mu      sync.Mutex
+	entries map[cacheKey]*list.Element
+	lru     *list.List
+
+	group singleflight.Group
+}
+
+var _ rpc.AuthClient = (*cachedClient)(nil)
+
+// NewCachedAuthClient creates AuthClient which caches allow and deny decisions for ttl,
+// keeping at most size decisions. Concurrent identical checks are made once.
+// Size must be positive.
+func NewCachedAuthClient(client rpc.AuthClient, ttl time.Duration, size int) rpc.AuthClient {
+	return &cachedClient{
+		client:  client,
+		ttl:     ttl,
+		size:    size,
+		entries: make(map[cacheKey]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// Check returns cached decision or calls the wrapped client.
+func (c *cachedClient) Check(ctx context.Context, endpoint string) error {
+	token := tokenFromContext(ctx)
+	if token == "" {
+		return c.client.Check(ctx, endpoint)
+	}
+
+	key := cacheKey{
+		token:    sha256.Sum256([]byte(token)),
+		endpoint: endpoint,
+	}
+
+	if entry, ok := c.get(key); ok {
+		metrics.IncAuthCacheRequests(cacheHit)
+		return entry.err
+	}
+
+	_, err, shared := c.group.Do(string(key.token[:])+endpoint, func() (interface{}, error) {
+		// The check is shared with other callers, so it must not be canceled together with the first one
+		err := c.client.Check(context.WithoutCancel(ctx), endpoint)
+		if isDecision(err) {
+			c.set(key, err, c.expiresAt(token))
+		}
+		return nil, err
+	})
+
+	if shared {
+		metrics.IncAuthCacheRequests(cacheShared)
+	} else {
+		metrics.IncAuthCacheRequests(cacheMiss)
+	}
+
+	return err
+}
+
+func (c *cachedClient) get(key cacheKey) (*cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := el.Value.(*cacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.remove(el)
+		return nil, false
+	}
+
+	c.lru.MoveToFront(el)
+
+	return entry, true
+}
+
+func (c *cachedClient) set(key cacheKey, err error, expiresAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+
+	c.entries[key] = c.lru.PushFront(&cacheEntry{
+		key:       key,
+		err:       err,
+		expiresAt: expiresAt,
+	})
+
+	for c.lru.Len() > c.size {
+		c.remove(c.lru.Back())
+	}
+
+	metrics.SetAuthCacheEntries(c.lru.Len())
+}
+
+func (c *cachedClient) remove(el *list.Element) {
+	c.lru.Remove(el)
+	delete(c.entries, el.Value.(*cacheEntry).key)
+}
+
+// expiresAt limits decision lifetime with the token expiration, so an expired token is checked again.
+func (c *cachedClient) expiresAt(token string) time.Time {
+	expiresAt := time.Now().Add(c.ttl)
+
+	claims := &jwt.RegisteredClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err == nil && claims.ExpiresAt != nil {
+		if claims.ExpiresAt.Before(expiresAt) {
+			return claims.ExpiresAt.Time
+		}
+	}
+
+	return expiresAt
+}
+
+// isDecision reports whether the result is an access decision rather than a transient failure.
+func isDecision(err error) bool {
+	switch status.Code(err) {
+	case codes.OK, codes.PermissionDenied, codes.Unauthenticated:
+		return true
+	default:
+		return false
+	}
+}
+
+func tokenFromContext(ctx context.Context) string {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(authMetadataHeader)
+	if len(values) == 0 || !strings.HasPrefix(values[0], authPrefix) {
+		return ""
+	}
+
+	return strings.TrimPrefix(values[0], authPrefix)
+}
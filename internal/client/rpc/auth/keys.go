@@ -0,0 +1,203 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	errUnknownKey        = errors.New("no public key for the token")
+	errUnsupportedMethod = errors.New("token signing method can not be verified offline")
+)
+
+// KeySet is a set of public keys used to verify access tokens.
+type KeySet struct {
+	byID      map[string]jwt.VerificationKey
+	anonymous []jwt.VerificationKey
+}
+
+// jwk is a single public key of a JWKS document.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// LoadKeySet reads public keys from a JWKS file or from a PEM file with public keys or certificates.
+func LoadKeySet(path string) (*KeySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public keys: %w", err)
+	}
+
+	keys := &KeySet{byID: make(map[string]jwt.VerificationKey)}
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "{") {
+		err = keys.parseJWKS(data)
+	} else {
+		err = keys.parsePEM(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(keys.byID) == 0 && len(keys.anonymous) == 0 {
+		return nil, fmt.Errorf("no public keys found in %s", path)
+	}
+
+	return keys, nil
+}
+
+func (k *KeySet) parsePEM(data []byte) error {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil
+		}
+
+		switch block.Type {
+		case "PUBLIC KEY":
+			key, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return fmt.Errorf("failed to parse public key: %w", err)
+			}
+			k.anonymous = append(k.anonymous, key)
+		case "RSA PUBLIC KEY":
+			key, err := x509.ParsePKCS1PublicKey(block.Bytes)
+			if err != nil {
+				return fmt.Errorf("failed to parse public key: %w", err)
+			}
+			k.anonymous = append(k.anonymous, key)
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return fmt.Errorf("failed to parse certificate: %w", err)
+			}
+			k.anonymous = append(k.anonymous, cert.PublicKey)
+		}
+	}
+}
+
+func (k *KeySet) parseJWKS(data []byte) error {
+	var doc struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("failed to parse JWKS: %w", err)
+	}
+
+	for _, j := range doc.Keys {
+		if j.Use != "" && j.Use != "sig" {
+			continue
+		}
+
+		key, err := j.publicKey()
+		if err != nil {
+			return fmt.Errorf("failed to parse JWK %q: %w", j.Kid, err)
+		}
+		if j.Kid == "" {
+			k.anonymous = append(k.anonymous, key)
+			continue
+		}
+		k.byID[j.Kid] = key
+	}
+
+	return nil
+}
+
+func (j *jwk) publicKey() (jwt.VerificationKey, error) {
+	switch j.Kty {
+	case "RSA":
+		n, err := decodeBigInt(j.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(j.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch j.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", j.Crv)
+		}
+		x, err := decodeBigInt(j.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(j.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if j.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", j.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(j.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key size")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", j.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
+
+// keyfunc picks the keys to verify the token with.
+// Tokens signed with a shared secret can not be verified with public keys.
+func (k *KeySet) keyfunc(token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS, *jwt.SigningMethodECDSA, *jwt.SigningMethodEd25519:
+	default:
+		return nil, errUnsupportedMethod
+	}
+
+	if kid, ok := token.Header["kid"].(string); ok {
+		if key, ok := k.byID[kid]; ok {
+			return key, nil
+		}
+	}
+	if len(k.anonymous) == 0 {
+		return nil, errUnknownKey
+	}
+
+	return jwt.VerificationKeySet{Keys: k.anonymous}, nil
+}
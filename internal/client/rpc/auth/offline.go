@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/8thgencore/microservice-auth/pkg/utils"
+	"github.com/8thgencore/microservice-chat/internal/client/rpc"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Policy maps endpoints to the roles allowed to call them, as in the auth service.
+type Policy map[string][]string
+
+// LoadPolicy reads the endpoint access policy from a JSON file.
+func LoadPolicy(path string) (Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read access policy: %w", err)
+	}
+
+	var policy Policy
+	if err = json.Unmarshal(data, &policy); err != nil {
+		return nil, fmt.Errorf("failed to parse access policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// accessClaims are the access token claims used to evaluate permissions.
+type accessClaims struct {
+	jwt.RegisteredClaims
+	Role      string   `json:"role"`
+	Endpoints []string `json:"endpoints"`
+}
+
+type offlineClient struct {
+	keys     *KeySet
+	policy   Policy
+	parser   *jwt.Parser
+	fallback rpc.AuthClient
+}
+
+var _ rpc.AuthClient = (*offlineClient)(nil)
+
+// NewOfflineAuthClient creates AuthClient which verifies access tokens with public keys
+// and checks endpoints against the token claims and the policy.
+// Tokens and endpoints it can not decide on are passed to fallback, if it is not nil.
+func NewOfflineAuthClient(keys *KeySet, policy Policy, issuer string, fallback rpc.AuthClient) rpc.AuthClient {
+	opts := []jwt.ParserOption{jwt.WithExpirationRequired()}
+	if issuer != "" {
+		opts = append(opts, jwt.WithIssuer(issuer))
+	}
+
+	return &offlineClient{
+		keys:     keys,
+		policy:   policy,
+		parser:   jwt.NewParser(opts...),
+		fallback: fallback,
+	}
+}
+
+// Check verifies the access token of the request and its permission to call the endpoint.
+func (c *offlineClient) Check(ctx context.Context, endpoint string) error {
+	token, err := utils.ExtractToken(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	claims := &accessClaims{}
+	_, err = c.parser.ParseWithClaims(token, claims, c.keys.keyfunc)
+	if err != nil {
+		if errors.Is(err, errUnknownKey) {
+			return c.undecided(ctx, endpoint, errUnknownKey)
+		}
+		if errors.Is(err, errUnsupportedMethod) {
+			return c.undecided(ctx, endpoint, errUnsupportedMethod)
+		}
+		return status.Error(codes.Unauthenticated, "access token is invalid")
+	}
+
+	// Permissions issued with the token take precedence over the role policy
+	if claims.Endpoints != nil {
+		return decide(slices.Contains(claims.Endpoints, endpoint))
+	}
+
+	roles, ok := c.policy[endpoint]
+	if !ok {
+		return c.undecided(ctx, endpoint, errors.New("endpoint is not in the access policy"))
+	}
+
+	return decide(slices.Contains(roles, claims.Role))
+}
+
+func (c *offlineClient) undecided(ctx context.Context, endpoint string, err error) error {
+	if c.fallback != nil {
+		return c.fallback.Check(ctx, endpoint)
+	}
+
+	return status.Error(codes.PermissionDenied, err.Error())
+}
+
+func decide(allowed bool) error {
+	if !allowed {
+		return status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	return nil
+}
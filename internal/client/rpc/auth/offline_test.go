@@ -0,0 +1,322 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// testToken describes an access token signed for a test.
+type testToken struct {
+	method jwt.SigningMethod
+	key    crypto.PrivateKey
+	kid    string
+	claims accessClaims
+}
+
+func (tt testToken) sign(t *testing.T) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(tt.method, tt.claims)
+	if tt.kid != "" {
+		token.Header["kid"] = tt.kid
+	}
+	signed, err := token.SignedString(tt.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return signed
+}
+
+func withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func generateEd25519(t *testing.T) (ed25519.PublicKey, ed25519.PrivateKey) {
+	t.Helper()
+
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return public, private
+}
+
+func TestOfflineClient(t *testing.T) {
+	public1, private1 := generateEd25519(t)
+	public2, private2 := generateEd25519(t)
+	_, forger := generateEd25519(t)
+
+	keys := &KeySet{byID: map[string]jwt.VerificationKey{"k1": public1, "k2": public2}}
+	policy := Policy{"/chat": {"USER", "ADMIN"}, "/admin": {"ADMIN"}}
+	fallback := status.Error(codes.Aborted, "decided by the auth service")
+
+	hour := jwt.NewNumericDate(time.Now().Add(time.Hour))
+	valid := accessClaims{RegisteredClaims: jwt.RegisteredClaims{Issuer: "auth", ExpiresAt: hour}, Role: "USER"}
+	with := func(change func(c *accessClaims)) accessClaims {
+		c := valid
+		change(&c)
+		return c
+	}
+
+	tests := []struct {
+		name     string
+		token    string
+		endpoint string
+		// noFallback leaves undecided checks to the offline client alone
+		noFallback bool
+		wantCode   codes.Code
+	}{
+		{
+			name:     "role allowed by the policy",
+			token:    testToken{jwt.SigningMethodEdDSA, private1, "k1", valid}.sign(t),
+			endpoint: "/chat",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "role denied by the policy",
+			token:    testToken{jwt.SigningMethodEdDSA, private1, "k1", valid}.sign(t),
+			endpoint: "/admin",
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "endpoints of the token take precedence",
+			token: testToken{jwt.SigningMethodEdDSA, private1, "k1", with(func(c *accessClaims) {
+				c.Endpoints = []string{"/admin"}
+			})}.sign(t),
+			endpoint: "/admin",
+			wantCode: codes.OK,
+		},
+		{
+			name: "endpoint missing from the token",
+			token: testToken{jwt.SigningMethodEdDSA, private1, "k1", with(func(c *accessClaims) {
+				c.Endpoints = []string{}
+			})}.sign(t),
+			endpoint: "/chat",
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "key selected by kid",
+			token:    testToken{jwt.SigningMethodEdDSA, private2, "k2", valid}.sign(t),
+			endpoint: "/chat",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "signed with another key than kid",
+			token:    testToken{jwt.SigningMethodEdDSA, private2, "k1", valid}.sign(t),
+			endpoint: "/chat",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "forged signature",
+			token:    testToken{jwt.SigningMethodEdDSA, forger, "k1", valid}.sign(t),
+			endpoint: "/chat",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "expired token",
+			token: testToken{jwt.SigningMethodEdDSA, private1, "k1", with(func(c *accessClaims) {
+				c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+			})}.sign(t),
+			endpoint: "/chat",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "token without expiry",
+			token: testToken{jwt.SigningMethodEdDSA, private1, "k1", with(func(c *accessClaims) {
+				c.ExpiresAt = nil
+			})}.sign(t),
+			endpoint: "/chat",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "other issuer",
+			token: testToken{jwt.SigningMethodEdDSA, private1, "k1", with(func(c *accessClaims) {
+				c.Issuer = "other"
+			})}.sign(t),
+			endpoint: "/chat",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "unknown kid falls back",
+			token:    testToken{jwt.SigningMethodEdDSA, private1, "k3", valid}.sign(t),
+			endpoint: "/chat",
+			wantCode: codes.Aborted,
+		},
+		{
+			name:       "unknown kid without fallback",
+			token:      testToken{jwt.SigningMethodEdDSA, private1, "k3", valid}.sign(t),
+			endpoint:   "/chat",
+			noFallback: true,
+			wantCode:   codes.PermissionDenied,
+		},
+		{
+			name:     "endpoint missing from the policy falls back",
+			token:    testToken{jwt.SigningMethodEdDSA, private1, "k1", valid}.sign(t),
+			endpoint: "/other",
+			wantCode: codes.Aborted,
+		},
+		{
+			// The public key is known to everyone, so it must never be accepted as a shared secret
+			name:     "HMAC signed with the public key falls back",
+			token:    testToken{jwt.SigningMethodHS256, []byte(public1), "k1", valid}.sign(t),
+			endpoint: "/chat",
+			wantCode: codes.Aborted,
+		},
+		{
+			name:       "HMAC without fallback",
+			token:      testToken{jwt.SigningMethodHS256, []byte(public1), "k1", valid}.sign(t),
+			endpoint:   "/chat",
+			noFallback: true,
+			wantCode:   codes.PermissionDenied,
+		},
+		{
+			name:       "unsigned token",
+			token:      testToken{jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "k1", valid}.sign(t),
+			endpoint:   "/chat",
+			noFallback: true,
+			wantCode:   codes.PermissionDenied,
+		},
+		{
+			name:     "malformed token",
+			token:    "not-a-token",
+			endpoint: "/chat",
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewOfflineAuthClient(keys, policy, "auth", staticClient{err: fallback})
+			if tt.noFallback {
+				client = NewOfflineAuthClient(keys, policy, "auth", nil)
+			}
+
+			if code := status.Code(client.Check(withToken(tt.token), tt.endpoint)); code != tt.wantCode {
+				t.Errorf("code = %v, want %v", code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestOfflineClientWithoutToken(t *testing.T) {
+	public, _ := generateEd25519(t)
+	client := NewOfflineAuthClient(&KeySet{anonymous: []jwt.VerificationKey{public}}, Policy{}, "", nil)
+
+	err := client.Check(metadata.NewIncomingContext(context.Background(), metadata.MD{}), "/chat")
+	if code := status.Code(err); code != codes.Unauthenticated {
+		t.Errorf("code = %v, want %v", code, codes.Unauthenticated)
+	}
+}
+
+func TestLoadKeySet(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edPublic, edPrivate := generateEd25519(t)
+	_, unlisted := generateEd25519(t)
+
+	encode := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	jwks, err := json.Marshal(map[string]any{"keys": []jwk{
+		{Kty: "RSA", Kid: "rsa", N: encode(rsaKey.N.Bytes()), E: encode(big.NewInt(int64(rsaKey.E)).Bytes())},
+		{Kty: "EC", Kid: "ec", Crv: "P-256", X: encode(ecKey.X.Bytes()), Y: encode(ecKey.Y.Bytes())},
+		{Kty: "OKP", Crv: "Ed25519", X: encode(edPublic)},
+		// Encryption keys must not verify tokens
+		{Kty: "OKP", Kid: "enc", Use: "enc", Crv: "Ed25519", X: encode(edPublic)},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(edPublic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	rsaPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PUBLIC KEY",
+		Bytes: x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey),
+	})
+
+	claims := accessClaims{RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))}}
+
+	tests := []struct {
+		name    string
+		file    []byte
+		token   testToken
+		wantErr bool
+	}{
+		{name: "JWKS RSA key", file: jwks, token: testToken{jwt.SigningMethodRS256, rsaKey, "rsa", claims}},
+		{name: "JWKS EC key", file: jwks, token: testToken{jwt.SigningMethodES256, ecKey, "ec", claims}},
+		{name: "JWKS key without kid", file: jwks, token: testToken{jwt.SigningMethodEdDSA, edPrivate, "", claims}},
+		{
+			name:    "JWKS encryption key",
+			file:    jwks,
+			token:   testToken{jwt.SigningMethodEdDSA, unlisted, "enc", claims},
+			wantErr: true,
+		},
+		{name: "PEM public key", file: publicPEM, token: testToken{jwt.SigningMethodEdDSA, edPrivate, "", claims}},
+		{name: "PEM RSA public key", file: rsaPEM, token: testToken{jwt.SigningMethodRS256, rsaKey, "", claims}},
+		{
+			name:    "PEM key of another signer",
+			file:    publicPEM,
+			token:   testToken{jwt.SigningMethodEdDSA, unlisted, "", claims},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "keys")
+			if err := os.WriteFile(path, tt.file, 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			keys, err := LoadKeySet(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = jwt.NewParser().ParseWithClaims(tt.token.sign(t), &accessClaims{}, keys.keyfunc)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseWithClaims() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadKeySetWithoutKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys")
+	if err := os.WriteFile(path, []byte(`{"keys": []}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := LoadKeySet(path); err == nil {
+		t.Error("LoadKeySet() error = nil, want error")
+	}
+}
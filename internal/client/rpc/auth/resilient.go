@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/8thgencore/microservice-auth/pkg/utils"
+	"github.com/8thgencore/microservice-chat/internal/client/rpc"
+	"github.com/8thgencore/microservice-chat/internal/config"
+	"github.com/8thgencore/microservice-common/pkg/logger"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// resilientClient bounds every check with a timeout, retries transient failures
+// and stops calling the auth service while it keeps failing.
+type resilientClient struct {
+	client  rpc.AuthClient
+	cfg     config.AuthClient
+	breaker *breaker
+}
+
+var _ rpc.AuthClient = (*resilientClient)(nil)
+
+// NewResilientAuthClient creates AuthClient with per-call timeout, retries with jittered backoff
+// and circuit breaker. When the auth service is unreachable it returns Unavailable.
+func NewResilientAuthClient(client rpc.AuthClient, cfg config.AuthClient) rpc.AuthClient {
+	return &resilientClient{
+		client:  client,
+		cfg:     cfg,
+		breaker: newBreaker(cfg.BreakerFailures, cfg.BreakerCooldown),
+	}
+}
+
+// Check calls the wrapped client until it makes a decision or retries are exhausted.
+func (c *resilientClient) Check(ctx context.Context, endpoint string) error {
+	var err error
+
+	for attempt := 0; attempt <= c.cfg.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(c.backoff(attempt)):
+			}
+		}
+
+		if !c.breaker.allow() {
+			return status.Error(codes.Unavailable, "auth service circuit breaker is open")
+		}
+
+		err = c.check(ctx, endpoint)
+		if !isTransient(err) {
+			c.breaker.success()
+			return err
+		}
+		c.breaker.failure()
+
+		if ctx.Err() != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+func (c *resilientClient) check(ctx context.Context, endpoint string) error {
+	if c.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.Timeout)
+		defer cancel()
+	}
+
+	return c.client.Check(ctx, endpoint)
+}
+
+// backoff returns exponential delay with full jitter before the retry.
+func (c *resilientClient) backoff(attempt int) time.Duration {
+	delay := c.cfg.RetryBackoff << min(attempt-1, 10)
+
+	return rand.N(delay + 1) //nolint:gosec
+}
+
+// isTransient reports whether the failure is worth retrying.
+func isTransient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// failOpenClient allows read-only endpoints when the auth service is unavailable.
+type failOpenClient struct {
+	client    rpc.AuthClient
+	keys      *KeySet
+	parser    *jwt.Parser
+	endpoints []string
+}
+
+var _ rpc.AuthClient = (*failOpenClient)(nil)
+
+// NewFailOpenAuthClient creates AuthClient which lets calls to the given read-only endpoints through
+// when the wrapped client fails with a transient error and the access token is signed with one of the keys.
+// Other endpoints and tokens which can not be verified stay fail-closed.
+func NewFailOpenAuthClient(client rpc.AuthClient, keys *KeySet, issuer string, endpoints []string) rpc.AuthClient {
+	opts := []jwt.ParserOption{jwt.WithExpirationRequired()}
+	if issuer != "" {
+		opts = append(opts, jwt.WithIssuer(issuer))
+	}
+
+	return &failOpenClient{
+		client:    client,
+		keys:      keys,
+		parser:    jwt.NewParser(opts...),
+		endpoints: endpoints,
+	}
+}
+
+// Check returns the decision of the wrapped client or allows a read-only endpoint.
+func (c *failOpenClient) Check(ctx context.Context, endpoint string) error {
+	err := c.client.Check(ctx, endpoint)
+	if !isTransient(err) || !slices.Contains(c.endpoints, endpoint) {
+		return err
+	}
+
+	// The caller is taken from the token later, so it must not be forged
+	token, errToken := utils.ExtractToken(ctx)
+	if errToken != nil {
+		return status.Error(codes.Unauthenticated, errToken.Error())
+	}
+	if _, errToken = c.parser.ParseWithClaims(token, &accessClaims{}, c.keys.keyfunc); errToken != nil {
+		logger.Warn("auth service is unavailable, access token can not be verified offline",
+			zap.String("endpoint", endpoint), zap.Error(errToken))
+		return err
+	}
+
+	logger.Warn("auth service is unavailable, read-only endpoint is allowed",
+		zap.String("endpoint", endpoint), zap.Error(err))
+	return nil
+}
@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/8thgencore/microservice-common/pkg/logger"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestMain(m *testing.M) {
+	logger.Init("test")
+	os.Exit(m.Run())
+}
+
+// staticClient returns the same result for every check.
+type staticClient struct {
+	err error
+}
+
+func (c staticClient) Check(context.Context, string) error {
+	return c.err
+}
+
+func signToken(t *testing.T, key ed25519.PrivateKey, expiresAt time.Time) string {
+	t.Helper()
+
+	claims := jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(expiresAt)}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims).SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func TestFailOpenClient(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, forger, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys := &KeySet{anonymous: []jwt.VerificationKey{public}}
+	unavailable := status.Error(codes.Unavailable, "auth service is down")
+	denied := status.Error(codes.PermissionDenied, "access denied")
+	hour := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		err      error
+		endpoint string
+		token    string
+		wantCode codes.Code
+	}{
+		{
+			name:     "decision of the auth service",
+			err:      denied,
+			endpoint: "/read",
+			token:    signToken(t, private, hour),
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "verified token on read-only endpoint",
+			err:      unavailable,
+			endpoint: "/read",
+			token:    signToken(t, private, hour),
+			wantCode: codes.OK,
+		},
+		{
+			name:     "other endpoint stays closed",
+			err:      unavailable,
+			endpoint: "/write",
+			token:    signToken(t, private, hour),
+			wantCode: codes.Unavailable,
+		},
+		{
+			name:     "forged token",
+			err:      unavailable,
+			endpoint: "/read",
+			token:    signToken(t, forger, hour),
+			wantCode: codes.Unavailable,
+		},
+		{
+			name:     "expired token",
+			err:      unavailable,
+			endpoint: "/read",
+			token:    signToken(t, private, time.Now().Add(-time.Hour)),
+			wantCode: codes.Unavailable,
+		},
+		{
+			name:     "unsigned token",
+			err:      unavailable,
+			endpoint: "/read",
+			token:    "eyJhbGciOiJub25lIn0.eyJleHAiOjk5OTk5OTk5OTl9.",
+			wantCode: codes.Unavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewFailOpenAuthClient(staticClient{err: tt.err}, keys, "", []string{"/read"})
+			ctx := metadata.NewIncomingContext(
+				context.Background(),
+				metadata.Pairs("authorization", "Bearer "+tt.token),
+			)
+
+			if code := status.Code(client.Check(ctx, tt.endpoint)); code != tt.wantCode {
+				t.Errorf("code = %v, want %v", code, tt.wantCode)
+			}
+		})
+	}
+}
@@ -1,12 +1,14 @@
 package config
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"net"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
@@ -29,9 +31,18 @@ const (
 type Config struct {
 	Env        Env `env:"ENV" env-default:"local"`
 	GRPC       GRPC
+	Prometheus PrometheusConfig
 	TLS        TLSConfig
 	Database   DatabaseConfig
 	AuthClient AuthClient
+	Chat       ChatConfig
+	Bot        Bot
+	RateLimit  RateLimit
+	Spam       Spam
+	Scheduler  Scheduler
+	Reaper     Reaper
+	Retention  Retention
+	Encryption Encryption
 }
 
 // GRPC represents the configuration for the GRPC server.
@@ -47,6 +58,17 @@ func (c *GRPC) Address() string {
 	return net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
 }
 
+// PrometheusConfig represents the configuration for the Prometheus.
+type PrometheusConfig struct {
+	Host string `env:"PROMETHEUS_HTTP_HOST" env-default:"0.0.0.0"`
+	Port int    `env:"PROMETHEUS_HTTP_PORT" env-default:"9090"`
+}
+
+// Address returns the address of the Prometheus server in the format "host:port".
+func (c *PrometheusConfig) Address() string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+}
+
 // DatabaseConfig represents the configuration for the Postgres database.
 type DatabaseConfig struct {
 	Host     string `env:"DB_HOST"     env-required:"true"`
@@ -67,6 +89,33 @@ type AuthClient struct {
 	Host     string `env:"AUTH_CLIENT_HOST" env-default:"auth"`
 	Port     int    `env:"AUTH_CLIENT_PORT" env-default:"50052"`
 	CertPath string `env:"AUTH_CERT_PATH"`
+
+	// ClientCertPath and ClientKeyPath are the client certificate for mutual TLS with the auth service.
+	ClientCertPath string `env:"AUTH_CLIENT_CERT_PATH"`
+	ClientKeyPath  string `env:"AUTH_CLIENT_KEY_PATH"`
+
+	// CacheTTL is how long access decisions are cached, zero disables the cache.
+	CacheTTL  time.Duration `env:"AUTH_CACHE_TTL"  env-default:"30s"`
+	CacheSize int           `env:"AUTH_CACHE_SIZE" env-default:"10000"`
+
+	Timeout         time.Duration `env:"AUTH_CLIENT_TIMEOUT"          env-default:"1s"`
+	MaxRetries      int           `env:"AUTH_CLIENT_MAX_RETRIES"      env-default:"2"`
+	RetryBackoff    time.Duration `env:"AUTH_CLIENT_RETRY_BACKOFF"    env-default:"100ms"`
+	BreakerFailures int           `env:"AUTH_CLIENT_BREAKER_FAILURES" env-default:"5"`
+	BreakerCooldown time.Duration `env:"AUTH_CLIENT_BREAKER_COOLDOWN" env-default:"10s"`
+
+	// FailOpen allows ReadOnlyEndpoints while the auth service is unavailable.
+	// Access tokens are then verified with PublicKeysPath, which must be set.
+	FailOpen          bool     `env:"AUTH_CLIENT_FAIL_OPEN"           env-default:"false"`
+	ReadOnlyEndpoints []string `env:"AUTH_CLIENT_READ_ONLY_ENDPOINTS" env-separator:"," env-default:"/chat_v1.ChatV1/Connect,/chat_v1.ChatV1/ListScheduled"`
+
+	// Offline verifies access tokens locally with PublicKeysPath and PolicyPath.
+	// The auth service is only called for tokens and endpoints it can not decide on, if RemoteFallback is set.
+	Offline        bool   `env:"AUTH_OFFLINE"          env-default:"false"`
+	PublicKeysPath string `env:"AUTH_PUBLIC_KEYS_PATH"`
+	PolicyPath     string `env:"AUTH_POLICY_PATH"`
+	Issuer         string `env:"AUTH_TOKEN_ISSUER"`
+	RemoteFallback bool   `env:"AUTH_REMOTE_FALLBACK"  env-default:"true"`
 }
 
 // Address returns the address of the authentication server in the format "host:port".
@@ -78,6 +127,142 @@ func (c *AuthClient) Address() string {
 type TLSConfig struct {
 	CertPath string `env:"TLS_CERT_PATH"`
 	KeyPath  string `env:"TLS_KEY_PATH"`
+
+	// ClientCAPath enables mutual TLS, clients must present a certificate signed by this CA.
+	ClientCAPath string `env:"TLS_CLIENT_CA_PATH"`
+}
+
+// ChatConfig represents the configuration for the chat service.
+type ChatConfig struct {
+	// CacheTTL is how long chat members and settings are cached.
+	CacheTTL time.Duration `env:"CHAT_CACHE_TTL" env-default:"1m"`
+	// FilterPath is the message filters file, it is reloaded on change. Messages are not filtered if empty.
+	FilterPath string `env:"CHAT_FILTER_PATH"`
+}
+
+// Bot represents the configuration for bot accounts.
+type Bot struct {
+	// Endpoints are the only endpoints bots authenticated with an API key may call.
+	Endpoints []string `env:"BOT_ENDPOINTS" env-separator:"," env-default:"/chat_v1.ChatV1/Connect,/chat_v1.ChatV1/SendMessage,/chat_v1.ChatV1/ScheduleMessage,/chat_v1.ChatV1/ListScheduled,/chat_v1.ChatV1/CancelScheduled"`
+}
+
+// RateLimit represents the configuration for request rate limiting.
+type RateLimit struct {
+	// Methods are token bucket limits applied per user, method and chat.
+	Methods MethodLimits `env:"RATE_LIMIT_METHODS" env-default:"/chat_v1.ChatV1/SendMessage=5:20,/chat_v1.ChatV1/Create=0.1:5,/chat_v1.ChatV1/Connect=1:10"`
+	// MaxStreams limits concurrent Connect streams of one user, zero disables the limit.
+	MaxStreams int `env:"RATE_LIMIT_MAX_STREAMS" env-default:"5"`
+	// IdleTTL is how long buckets of inactive callers are kept.
+	IdleTTL time.Duration `env:"RATE_LIMIT_IDLE_TTL" env-default:"10m"`
+}
+
+// MethodLimit is a token bucket limit of one method.
+type MethodLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// RefillTime returns how long an empty bucket takes to fill up again.
+func (l MethodLimit) RefillTime() time.Duration {
+	if l.Rate <= 0 {
+		return 0
+	}
+
+	return time.Duration(float64(l.Burst) / l.Rate * float64(time.Second))
+}
+
+// MethodLimits maps full method names to their limits.
+type MethodLimits map[string]MethodLimit
+
+// SetValue parses limits in the form "<method>=<requests per second>:<burst>" separated by commas.
+func (m *MethodLimits) SetValue(s string) error {
+	limits := make(MethodLimits)
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		method, value, ok := strings.Cut(item, "=")
+		if !ok {
+			return fmt.Errorf("invalid rate limit %q", item)
+		}
+		r, b, ok := strings.Cut(value, ":")
+		if !ok {
+			return fmt.Errorf("invalid rate limit %q", item)
+		}
+
+		rate, err := strconv.ParseFloat(r, 64)
+		if err != nil {
+			return fmt.Errorf("invalid rate of %s: %w", method, err)
+		}
+		burst, err := strconv.Atoi(b)
+		if err != nil {
+			return fmt.Errorf("invalid burst of %s: %w", method, err)
+		}
+
+		limits[method] = MethodLimit{Rate: rate, Burst: burst}
+	}
+
+	*m = limits
+
+	return nil
+}
+
+// Spam represents the configuration for flood and duplicate messages detection.
+type Spam struct {
+	// Window is the sliding window of messages checked per user, zero disables detection.
+	Window time.Duration `env:"SPAM_WINDOW" env-default:"30s"`
+	// MaxMessages, MaxDuplicates and MaxChats are the most messages, copies of one text
+	// and chats receiving one text allowed within the window. Zero disables the check.
+	MaxMessages   int `env:"SPAM_MAX_MESSAGES"   env-default:"20"`
+	MaxDuplicates int `env:"SPAM_MAX_DUPLICATES" env-default:"3"`
+	MaxChats      int `env:"SPAM_MAX_CHATS"      env-default:"3"`
+	// Actions taken when the limits are exceeded: throttle, reject or mute.
+	FloodAction     string `env:"SPAM_FLOOD_ACTION"      env-default:"throttle"`
+	DuplicateAction string `env:"SPAM_DUPLICATE_ACTION"  env-default:"reject"`
+	CrossChatAction string `env:"SPAM_CROSS_CHAT_ACTION" env-default:"mute"`
+	// MuteDuration is how long the mute action silences the sender.
+	MuteDuration time.Duration `env:"SPAM_MUTE_DURATION" env-default:"10m"`
+
+	// RedisAddress shares windows between replicas, they are kept in memory if empty.
+	RedisAddress  string `env:"SPAM_REDIS_ADDRESS"`
+	RedisPassword string `env:"SPAM_REDIS_PASSWORD"`
+	RedisDB       int    `env:"SPAM_REDIS_DB"       env-default:"0"`
+}
+
+// Scheduler represents the configuration for the scheduled messages worker.
+type Scheduler struct {
+	Interval    time.Duration `env:"SCHEDULER_INTERVAL"     env-default:"1s"`
+	BatchSize   int           `env:"SCHEDULER_BATCH_SIZE"   env-default:"100"`
+	MaxAttempts int           `env:"SCHEDULER_MAX_ATTEMPTS" env-default:"5"`
+	RetryDelay  time.Duration `env:"SCHEDULER_RETRY_DELAY"  env-default:"5s"`
+}
+
+// Reaper represents the configuration for the expired messages worker.
+type Reaper struct {
+	Interval  time.Duration `env:"REAPER_INTERVAL"   env-default:"10s"`
+	BatchSize uint64        `env:"REAPER_BATCH_SIZE" env-default:"500"`
+}
+
+// Retention represents the configuration for the message retention purge.
+type Retention struct {
+	// DefaultPeriod applies to chats without own retention, zero keeps messages forever.
+	DefaultPeriod time.Duration `env:"RETENTION_DEFAULT_PERIOD" env-default:"0s"`
+	Interval      time.Duration `env:"RETENTION_INTERVAL"       env-default:"1h"`
+	BatchSize     uint64        `env:"RETENTION_BATCH_SIZE"     env-default:"1000"`
+	DryRun        bool          `env:"RETENTION_DRY_RUN"        env-default:"false"`
+}
+
+// Encryption represents the configuration for encryption of message texts at rest.
+type Encryption struct {
+	// KeyFile holds master keys wrapping chat data keys, texts are stored unencrypted if empty.
+	KeyFile string `env:"ENCRYPTION_KEY_FILE"`
+	// KeyRotationPeriod is the age of a chat data key after which a new one is used, zero disables rotation.
+	KeyRotationPeriod time.Duration `env:"ENCRYPTION_KEY_ROTATION_PERIOD" env-default:"720h"`
+	// Interval and BatchSize control the background re-encryption of messages under old keys.
+	Interval  time.Duration `env:"ENCRYPTION_INTERVAL"   env-default:"1m"`
+	BatchSize uint64        `env:"ENCRYPTION_BATCH_SIZE" env-default:"500"`
 }
 
 // NewConfig creates a new instance of Config
@@ -100,9 +285,62 @@ func NewConfig() (*Config, error) {
 		return nil, fmt.Errorf("error reading env: %w", err)
 	}
 
+	if err = cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
 	return cfg, nil
 }
 
+// validate rejects values the workers and caches can not run with.
+func (c *Config) validate() error {
+	if c.AuthClient.CacheTTL > 0 && c.AuthClient.CacheSize <= 0 {
+		return errors.New("AUTH_CACHE_SIZE must be positive when the auth cache is enabled")
+	}
+
+	if c.AuthClient.FailOpen && c.AuthClient.PublicKeysPath == "" {
+		return errors.New("AUTH_PUBLIC_KEYS_PATH must be set to verify access tokens when AUTH_CLIENT_FAIL_OPEN is enabled")
+	}
+
+	// Worker intervals are passed to time.NewTicker, which panics on non-positive ones
+	if c.Scheduler.Interval <= 0 {
+		return errors.New("SCHEDULER_INTERVAL must be positive")
+	}
+	if c.Scheduler.BatchSize <= 0 {
+		return errors.New("SCHEDULER_BATCH_SIZE must be positive")
+	}
+	if c.Reaper.Interval <= 0 {
+		return errors.New("REAPER_INTERVAL must be positive")
+	}
+	if c.Retention.Interval <= 0 {
+		return errors.New("RETENTION_INTERVAL must be positive")
+	}
+	// The re-encryption worker only runs with master keys
+	if c.Encryption.KeyFile != "" && c.Encryption.Interval <= 0 {
+		return errors.New("ENCRYPTION_INTERVAL must be positive")
+	}
+
+	// Workers keep deleting while batches come back full, an empty batch would never end the run
+	if c.Reaper.BatchSize == 0 {
+		return errors.New("REAPER_BATCH_SIZE must be positive")
+	}
+	if c.Retention.BatchSize == 0 {
+		return errors.New("RETENTION_BATCH_SIZE must be positive")
+	}
+
+	if c.RateLimit.IdleTTL <= 0 {
+		return errors.New("RATE_LIMIT_IDLE_TTL must be positive")
+	}
+	for method, limit := range c.RateLimit.Methods {
+		// A bucket must not be dropped before it refills, otherwise its limit is reset early
+		if refill := limit.RefillTime(); limit.Rate > 0 && c.RateLimit.IdleTTL < refill {
+			return fmt.Errorf("RATE_LIMIT_IDLE_TTL must be at least %v, the refill time of %s", refill, method)
+		}
+	}
+
+	return nil
+}
+
 func fetchConfigPath() string {
 	var configPath string
 	flag.StringVar(&configPath, "config", ".env", "Path to config file")
@@ -0,0 +1,62 @@
+package converter
+
+import (
+	"log"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	chatv1 "github.com/8thgencore/microservice-chat/pkg/chat/v1"
+)
+
+// ToLogFilterFromDesc converts structure of API layer to service layer model.
+func ToLogFilterFromDesc(req *chatv1.QueryAuditLogRequest) model.LogFilter {
+	filter := model.LogFilter{
+		Actor:  req.GetActor(),
+		Action: req.GetAction(),
+		ChatID: req.GetChatId(),
+		From:   toTime(req.GetFrom()),
+		To:     toTime(req.GetTo()),
+	}
+	if req.GetPageSize() > 0 {
+		filter.Limit = uint64(req.GetPageSize())
+	}
+
+	return filter
+}
+
+// ToAuditRecordsFromService converts service layer models to structures of API layer.
+func ToAuditRecordsFromService(records []*model.Log) []*chatv1.AuditRecord {
+	res := make([]*chatv1.AuditRecord, 0, len(records))
+	for _, r := range records {
+		payload, err := structpb.NewStruct(r.Payload)
+		if err != nil {
+			// Payloads are read from JSON, so this only happens if a record is malformed
+			log.Printf("failed to convert payload of audit record %s: %v", r.ID, err)
+		}
+
+		res = append(res, &chatv1.AuditRecord{
+			Id:        r.ID,
+			Timestamp: timestamppb.New(r.Timestamp),
+			Actor:     r.Actor,
+			Action:    r.Action,
+			ChatId:    r.ChatID,
+			MessageId: r.MessageID,
+			RequestId: r.RequestID,
+			Payload:   payload,
+			Text:      r.Text,
+		})
+	}
+
+	return res
+}
+
+// toTime converts an unset timestamp to zero time instead of the Unix epoch.
+func toTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
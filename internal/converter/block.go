@@ -0,0 +1,21 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	chatv1 "github.com/8thgencore/microservice-chat/pkg/chat/v1"
+)
+
+// ToBlockedUsersFromService converts service layer models to structures of API layer.
+func ToBlockedUsersFromService(blocks []*model.Block) []*chatv1.BlockedUser {
+	res := make([]*chatv1.BlockedUser, 0, len(blocks))
+	for _, b := range blocks {
+		res = append(res, &chatv1.BlockedUser{
+			Username:  b.Blocked,
+			CreatedAt: timestamppb.New(b.CreatedAt),
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,20 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	chatv1 "github.com/8thgencore/microservice-chat/pkg/chat/v1"
+)
+
+// ToBotFromService converts service layer model to structure of API layer.
+func ToBotFromService(bot *model.Bot) *chatv1.Bot {
+	return &chatv1.Bot{
+		Id:           bot.ID,
+		Name:         bot.Name,
+		Username:     bot.Username(),
+		CreatedBy:    bot.CreatedBy,
+		CreatedAt:    timestamppb.New(bot.CreatedAt),
+		KeyRotatedAt: timestamppb.New(bot.KeyRotatedAt),
+	}
+}
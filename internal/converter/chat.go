@@ -1,6 +1,9 @@
 package converter
 
 import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/8thgencore/microservice-chat/internal/model"
@@ -9,27 +12,62 @@ import (
 
 // ToChatFromService converts service layer model to structure of API layer.
 func ToChatFromService(chat *model.Chat) *chatv1.Chat {
-	return &chatv1.Chat{
-		Usernames: chat.Usernames,
+	res := &chatv1.Chat{
+		Usernames:  chat.Usernames,
+		MessageTtl: durationpb.New(chat.MessageTTL),
+		Retention:  durationpb.New(chat.Retention),
+		E2E:        chat.E2E,
+	}
+	if chat.Retention == model.RetentionForever {
+		res.Retention = durationpb.New(0)
+		res.KeepForever = true
 	}
+
+	return res
 }
 
 // ToChatFromDesc converts structure of API layer to service layer model.
 func ToChatFromDesc(chat *chatv1.Chat) *model.Chat {
 	return &model.Chat{
-		Usernames: chat.Usernames,
+		Usernames:  chat.GetUsernames(),
+		MessageTTL: chat.GetMessageTtl().AsDuration(),
+		Retention:  ToRetentionFromDesc(chat.GetRetention(), chat.GetKeepForever()),
+		E2E:        chat.GetE2E(),
+	}
+}
+
+// ToRetentionFromDesc converts chat retention of API layer to service layer value.
+func ToRetentionFromDesc(retention *durationpb.Duration, keepForever bool) time.Duration {
+	if keepForever {
+		return model.RetentionForever
 	}
+
+	return retention.AsDuration()
 }
 
 // ToMessageFromDesc converts structure of API layer to service layer model.
 func ToMessageFromDesc(message *chatv1.Message) *model.Message {
 	return &model.Message{
-		From:      message.From,
-		Text:      message.Text,
-		Timestamp: message.Timestamp.AsTime(),
+		From:      message.GetFrom(),
+		Text:      message.GetText(),
+		Timestamp: message.GetTimestamp().AsTime(),
+		TTL:       message.GetTtl().AsDuration(),
+		Payloads:  ToDevicePayloadsFromDesc(message.GetPayloads()),
+
+		ClientMessageID: message.GetClientMessageId(),
 	}
 }
 
+// ToMessagesFromDesc converts structures of API layer to service layer models.
+func ToMessagesFromDesc(messages []*chatv1.Message) []*model.Message {
+	res := make([]*model.Message, 0, len(messages))
+	for _, m := range messages {
+		res = append(res, ToMessageFromDesc(m))
+	}
+
+	return res
+}
+
 // ToStreamFromDesc converts interface of API layer to service layer interface.
 func ToStreamFromDesc(stream chatv1.ChatV1_ConnectServer) model.Stream {
 	return stream.(model.Stream)
@@ -37,9 +75,39 @@ func ToStreamFromDesc(stream chatv1.ChatV1_ConnectServer) model.Stream {
 
 // ToMessageFromService converts service layer model to structure of API layer.
 func ToMessageFromService(message *model.Message) *chatv1.Message {
-	return &chatv1.Message{
+	res := &chatv1.Message{
+		Id:        message.ID,
 		From:      message.From,
 		Text:      message.Text,
 		Timestamp: timestamppb.New(message.Timestamp),
+		Event:     chatv1.EventType(message.Event),
+
+		ClientMessageId: message.ClientMessageID,
+	}
+	if !message.ExpiresAt.IsZero() {
+		res.ExpiresAt = timestamppb.New(message.ExpiresAt)
+	}
+	if message.Report != nil {
+		res.Report = ToReportFromService(message.Report)
+	}
+	if len(message.Payloads) > 0 {
+		res.Payloads = ToDevicePayloadsFromService(message.Payloads)
 	}
+
+	return res
+}
+
+// ToScheduledMessagesFromService converts service layer models to structures of API layer.
+func ToScheduledMessagesFromService(messages []*model.ScheduledMessage) []*chatv1.ScheduledMessage {
+	res := make([]*chatv1.ScheduledMessage, 0, len(messages))
+	for _, m := range messages {
+		res = append(res, &chatv1.ScheduledMessage{
+			Id:      m.ID,
+			ChatId:  m.ChatID,
+			Message: ToMessageFromService(m.Message),
+			SendAt:  timestamppb.New(m.SendAt),
+		})
+	}
+
+	return res
 }
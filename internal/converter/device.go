@@ -0,0 +1,61 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	chatv1 "github.com/8thgencore/microservice-chat/pkg/chat/v1"
+)
+
+// ToDeviceKeyFromService converts service layer model to structure of API layer.
+func ToDeviceKeyFromService(key *model.DeviceKey) *chatv1.DeviceKey {
+	return &chatv1.DeviceKey{
+		Id:        key.ID,
+		Username:  key.Username,
+		DeviceId:  key.DeviceID,
+		PublicKey: key.PublicKey,
+		CreatedAt: timestamppb.New(key.CreatedAt),
+	}
+}
+
+// ToDeviceKeysFromService converts service layer models to structures of API layer.
+func ToDeviceKeysFromService(keys []*model.DeviceKey) []*chatv1.DeviceKey {
+	res := make([]*chatv1.DeviceKey, 0, len(keys))
+	for _, k := range keys {
+		res = append(res, ToDeviceKeyFromService(k))
+	}
+
+	return res
+}
+
+// ToDevicePayloadsFromDesc converts structures of API layer to service layer models.
+func ToDevicePayloadsFromDesc(payloads []*chatv1.DevicePayload) []*model.DevicePayload {
+	if len(payloads) == 0 {
+		return nil
+	}
+
+	res := make([]*model.DevicePayload, 0, len(payloads))
+	for _, p := range payloads {
+		res = append(res, &model.DevicePayload{
+			Recipient:  p.GetRecipient(),
+			DeviceID:   p.GetDeviceId(),
+			Ciphertext: p.GetCiphertext(),
+		})
+	}
+
+	return res
+}
+
+// ToDevicePayloadsFromService converts service layer models to structures of API layer.
+func ToDevicePayloadsFromService(payloads []*model.DevicePayload) []*chatv1.DevicePayload {
+	res := make([]*chatv1.DevicePayload, 0, len(payloads))
+	for _, p := range payloads {
+		res = append(res, &chatv1.DevicePayload{
+			Recipient:  p.Recipient,
+			DeviceId:   p.DeviceID,
+			Ciphertext: p.Ciphertext,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,81 @@
+package converter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	chatv1 "github.com/8thgencore/microservice-chat/pkg/chat/v1"
+)
+
+// exportRecord is the JSON format of user data export records documented in chat.proto.
+type exportRecord struct {
+	Kind            model.ExportKind `json:"kind"`
+	ID              string           `json:"id,omitempty"`
+	Username        string           `json:"username,omitempty"`
+	ExportedAt      *time.Time       `json:"exported_at,omitempty"`
+	ChatID          string           `json:"chat_id,omitempty"`
+	Role            model.ChatRole   `json:"role,omitempty"`
+	JoinedAt        *time.Time       `json:"joined_at,omitempty"`
+	Text            *string          `json:"text,omitempty"`
+	Timestamp       *time.Time       `json:"timestamp,omitempty"`
+	ExpiresAt       *time.Time       `json:"expires_at,omitempty"`
+	ClientMessageID string           `json:"client_message_id,omitempty"`
+	DeviceID        string           `json:"device_id,omitempty"`
+	PublicKey       []byte           `json:"public_key,omitempty"`
+	CreatedAt       *time.Time       `json:"created_at,omitempty"`
+}
+
+// ToExportUserDataResponseFromService converts service layer model to structure of API layer.
+func ToExportUserDataResponseFromService(record *model.ExportRecord) (*chatv1.ExportUserDataResponse, error) {
+	res := exportRecord{Kind: record.Kind}
+
+	switch record.Kind {
+	case model.ExportUser:
+		res.Username = record.Username
+		res.ExportedAt = timeOrNil(record.ExportedAt)
+	case model.ExportMembership:
+		res.ChatID = record.Membership.ChatID
+		res.Role = record.Membership.Role
+		res.JoinedAt = timeOrNil(record.Membership.JoinedAt)
+	case model.ExportMessage:
+		res.ID = record.Message.ID
+		res.ChatID = record.Message.ChatID
+		res.Text = &record.Message.Text
+		res.Timestamp = timeOrNil(record.Message.Timestamp)
+		res.ExpiresAt = timeOrNil(record.Message.ExpiresAt)
+		res.ClientMessageID = record.Message.ClientMessageID
+	case model.ExportBlock:
+		res.Username = record.Block.Blocked
+		res.CreatedAt = timeOrNil(record.Block.CreatedAt)
+	case model.ExportDeviceKey:
+		res.ID = record.DeviceKey.ID
+		res.DeviceID = record.DeviceKey.DeviceID
+		res.PublicKey = record.DeviceKey.PublicKey
+		res.CreatedAt = timeOrNil(record.DeviceKey.CreatedAt)
+	}
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.ExportUserDataResponse{Record: data}, nil
+}
+
+// ToEraseUserResponseFromService converts service layer model to structure of API layer.
+func ToEraseUserResponseFromService(erasure *model.Erasure) *chatv1.EraseUserResponse {
+	return &chatv1.EraseUserResponse{
+		Pseudonym: erasure.Pseudonym,
+		ChatIds:   erasure.ChatIDs,
+		Messages:  int64(erasure.Messages),
+	}
+}
+
+func timeOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
@@ -0,0 +1,80 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	chatv1 "github.com/8thgencore/microservice-chat/pkg/chat/v1"
+)
+
+var reportStatuses = map[chatv1.ReportStatus]model.ReportStatus{
+	chatv1.ReportStatus_REPORT_STATUS_OPEN:            model.ReportOpen,
+	chatv1.ReportStatus_REPORT_STATUS_DISMISSED:       model.ReportDismissed,
+	chatv1.ReportStatus_REPORT_STATUS_MESSAGE_DELETED: model.ReportMessageDeleted,
+	chatv1.ReportStatus_REPORT_STATUS_AUTHOR_BANNED:   model.ReportAuthorBanned,
+}
+
+// ToReportStatusFromDesc converts report status of API layer to service layer model.
+// Unknown statuses are passed as is, so the service layer rejects them.
+func ToReportStatusFromDesc(status chatv1.ReportStatus) model.ReportStatus {
+	if res, ok := reportStatuses[status]; ok {
+		return res
+	}
+
+	return model.ReportStatus(status.String())
+}
+
+// ToReportFilterFromDesc converts structure of API layer to service layer model.
+func ToReportFilterFromDesc(req *chatv1.ListReportsRequest) model.ReportFilter {
+	filter := model.ReportFilter{
+		ChatID: req.GetChatId(),
+		Status: ToReportStatusFromDesc(req.GetStatus()),
+	}
+	if req.GetPageSize() > 0 {
+		filter.Limit = uint64(req.GetPageSize())
+	}
+
+	return filter
+}
+
+// ToReportStatusFromService converts service layer report status to API layer.
+func ToReportStatusFromService(status model.ReportStatus) chatv1.ReportStatus {
+	for desc, s := range reportStatuses {
+		if s == status {
+			return desc
+		}
+	}
+
+	return chatv1.ReportStatus_REPORT_STATUS_OPEN
+}
+
+// ToReportFromService converts service layer model to structure of API layer.
+func ToReportFromService(report *model.Report) *chatv1.Report {
+	res := &chatv1.Report{
+		Id:          report.ID,
+		ChatId:      report.ChatID,
+		MessageId:   report.MessageID,
+		MessageFrom: report.MessageFrom,
+		MessageText: report.MessageText,
+		Reporter:    report.Reporter,
+		Reason:      report.Reason,
+		Status:      ToReportStatusFromService(report.Status),
+		ResolvedBy:  report.ResolvedBy,
+		CreatedAt:   timestamppb.New(report.CreatedAt),
+	}
+	if report.ResolvedAt != nil {
+		res.ResolvedAt = timestamppb.New(*report.ResolvedAt)
+	}
+
+	return res
+}
+
+// ToReportsFromService converts service layer models to structures of API layer.
+func ToReportsFromService(reports []*model.Report) []*chatv1.Report {
+	res := make([]*chatv1.Report, 0, len(reports))
+	for _, r := range reports {
+		res = append(res, ToReportFromService(r))
+	}
+
+	return res
+}
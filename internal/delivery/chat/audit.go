@@ -0,0 +1,25 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/8thgencore/microservice-chat/internal/converter"
+
+	chatv1 "github.com/8thgencore/microservice-chat/pkg/chat/v1"
+)
+
+// QueryAuditLog is used for reading the audit log page by page.
+func (i *Implementation) QueryAuditLog(
+	ctx context.Context,
+	req *chatv1.QueryAuditLogRequest,
+) (*chatv1.QueryAuditLogResponse, error) {
+	records, next, err := i.auditService.QueryAuditLog(ctx, converter.ToLogFilterFromDesc(req), req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.QueryAuditLogResponse{
+		Records:       converter.ToAuditRecordsFromService(records),
+		NextPageToken: next,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/8thgencore/microservice-chat/internal/converter"
+	"github.com/golang/protobuf/ptypes/empty"
+
+	chatv1 "github.com/8thgencore/microservice-chat/pkg/chat/v1"
+)
+
+// BlockUser is used for hiding a user's messages from the caller.
+func (i *Implementation) BlockUser(ctx context.Context, req *chatv1.BlockUserRequest) (*empty.Empty, error) {
+	err := i.chatService.BlockUser(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// UnblockUser is used for lifting the caller's block of a user.
+func (i *Implementation) UnblockUser(ctx context.Context, req *chatv1.UnblockUserRequest) (*empty.Empty, error) {
+	err := i.chatService.UnblockUser(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// ListBlocked is used for listing users blocked by the caller.
+func (i *Implementation) ListBlocked(
+	ctx context.Context,
+	_ *chatv1.ListBlockedRequest,
+) (*chatv1.ListBlockedResponse, error) {
+	blocks, err := i.chatService.ListBlocked(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.ListBlockedResponse{
+		Users: converter.ToBlockedUsersFromService(blocks),
+	}, nil
+}
@@ -0,0 +1,49 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/8thgencore/microservice-chat/internal/converter"
+	"github.com/golang/protobuf/ptypes/empty"
+
+	chatv1 "github.com/8thgencore/microservice-chat/pkg/chat/v1"
+)
+
+// CreateBot is used for registering a bot account.
+// The API key is returned only once.
+func (i *Implementation) CreateBot(ctx context.Context, req *chatv1.CreateBotRequest) (*chatv1.CreateBotResponse, error) {
+	bot, apiKey, err := i.botService.CreateBot(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.CreateBotResponse{
+		Bot:    converter.ToBotFromService(bot),
+		ApiKey: apiKey,
+	}, nil
+}
+
+// RotateBotKey is used for issuing a new API key for the bot.
+func (i *Implementation) RotateBotKey(
+	ctx context.Context,
+	req *chatv1.RotateBotKeyRequest,
+) (*chatv1.RotateBotKeyResponse, error) {
+	apiKey, err := i.botService.RotateBotKey(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.RotateBotKeyResponse{
+		ApiKey: apiKey,
+	}, nil
+}
+
+// RevokeBot is used for disabling the bot account.
+func (i *Implementation) RevokeBot(ctx context.Context, req *chatv1.RevokeBotRequest) (*empty.Empty, error) {
+	err := i.botService.RevokeBot(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
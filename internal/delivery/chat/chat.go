@@ -12,7 +12,12 @@ import (
 
 // Connect is used for connecting to a chat.
 func (i *Implementation) Connect(req *chatv1.ConnectRequest, stream chatv1.ChatV1_ConnectServer) error {
-	err := i.chatService.Connect(req.GetChatId(), req.GetUsername(), converter.ToStreamFromDesc(stream))
+	err := i.chatService.Connect(
+		req.GetChatId(),
+		req.GetUsername(),
+		req.GetIncludeBlocked(),
+		converter.ToStreamFromDesc(stream),
+	)
 	log.Println(err)
 	return err
 }
@@ -40,8 +45,40 @@ func (i *Implementation) Delete(ctx context.Context, req *chatv1.DeleteRequest)
 }
 
 // SendMessage is used for sending messages to connected chat.
-func (i *Implementation) SendMessage(ctx context.Context, req *chatv1.SendMessageRequest) (*empty.Empty, error) {
-	err := i.chatService.SendMessage(ctx, req.GetChatId(), converter.ToMessageFromDesc(req.GetMessage()))
+func (i *Implementation) SendMessage(
+	ctx context.Context,
+	req *chatv1.SendMessageRequest,
+) (*chatv1.SendMessageResponse, error) {
+	message := converter.ToMessageFromDesc(req.GetMessage())
+
+	stored, duplicate, err := i.chatService.SendMessage(ctx, req.GetChatId(), message)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.SendMessageResponse{
+		Message:   converter.ToMessageFromService(stored),
+		Duplicate: duplicate,
+	}, nil
+}
+
+// SetMessageTTL is used for setting the disappearing-message timer of the chat.
+func (i *Implementation) SetMessageTTL(ctx context.Context, req *chatv1.SetMessageTTLRequest) (*empty.Empty, error) {
+	err := i.chatService.SetMessageTTL(ctx, req.GetChatId(), req.GetTtl().AsDuration())
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// SetRetention is used for setting how long messages are kept in the chat.
+func (i *Implementation) SetRetention(ctx context.Context, req *chatv1.SetRetentionRequest) (*empty.Empty, error) {
+	err := i.chatService.SetRetention(
+		ctx,
+		req.GetChatId(),
+		converter.ToRetentionFromDesc(req.GetRetention(), req.GetKeepForever()),
+	)
 	if err != nil {
 		return nil, err
 	}
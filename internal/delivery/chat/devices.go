@@ -0,0 +1,53 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/8thgencore/microservice-chat/internal/converter"
+	"github.com/golang/protobuf/ptypes/empty"
+
+	chatv1 "github.com/8thgencore/microservice-chat/pkg/chat/v1"
+)
+
+// RegisterDeviceKey is used for publishing the public key of the caller's device.
+func (i *Implementation) RegisterDeviceKey(
+	ctx context.Context,
+	req *chatv1.RegisterDeviceKeyRequest,
+) (*chatv1.RegisterDeviceKeyResponse, error) {
+	key, err := i.deviceService.RegisterDeviceKey(ctx, req.GetDeviceId(), req.GetPublicKey())
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.RegisterDeviceKeyResponse{
+		Key: converter.ToDeviceKeyFromService(key),
+	}, nil
+}
+
+// ListDeviceKeys is used for getting the device keys messages to the user are encrypted with.
+func (i *Implementation) ListDeviceKeys(
+	ctx context.Context,
+	req *chatv1.ListDeviceKeysRequest,
+) (*chatv1.ListDeviceKeysResponse, error) {
+	keys, err := i.deviceService.ListDeviceKeys(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.ListDeviceKeysResponse{
+		Keys: converter.ToDeviceKeysFromService(keys),
+	}, nil
+}
+
+// RevokeDeviceKey is used for revoking the key of a lost or retired device.
+func (i *Implementation) RevokeDeviceKey(
+	ctx context.Context,
+	req *chatv1.RevokeDeviceKeyRequest,
+) (*empty.Empty, error) {
+	err := i.deviceService.RevokeDeviceKey(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,55 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/8thgencore/microservice-chat/internal/converter"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	chatv1 "github.com/8thgencore/microservice-chat/pkg/chat/v1"
+)
+
+// ImportMessages is used for bulk loading of chat history.
+// Every request of the stream is imported as a separate batch, a failed batch does not stop the import.
+func (i *Implementation) ImportMessages(stream chatv1.ChatV1_ImportMessagesServer) error {
+	res := &chatv1.ImportMessagesResponse{}
+
+	for batch := int32(1); ; batch++ {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(res)
+		}
+		if err != nil {
+			return err
+		}
+
+		imported, skipped, err := i.importBatch(stream.Context(), req)
+		if status.Code(err) == codes.PermissionDenied {
+			return err
+		}
+
+		result := &chatv1.ImportBatchResult{
+			Batch:    batch,
+			Imported: int32(imported), //nolint:gosec
+			Skipped:  int32(skipped),  //nolint:gosec
+		}
+		if err != nil {
+			result.Error = err.Error()
+			res.Failed += int64(len(req.GetMessages()))
+		}
+		res.Imported += int64(imported)
+		res.Batches = append(res.Batches, result)
+	}
+}
+
+// importBatch validates the request here rather than in the interceptor, so an invalid batch only fails itself.
+func (i *Implementation) importBatch(ctx context.Context, req *chatv1.ImportMessagesRequest) (int, int, error) {
+	if err := req.ValidateAll(); err != nil {
+		return 0, 0, err
+	}
+
+	return i.chatService.ImportMessages(ctx, req.GetChatId(), converter.ToMessagesFromDesc(req.GetMessages()))
+}
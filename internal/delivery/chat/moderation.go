@@ -0,0 +1,49 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	chatv1 "github.com/8thgencore/microservice-chat/pkg/chat/v1"
+)
+
+// BanUser is used for keeping a user out of the chat.
+func (i *Implementation) BanUser(ctx context.Context, req *chatv1.BanUserRequest) (*empty.Empty, error) {
+	err := i.chatService.BanUser(ctx, req.GetChatId(), req.GetUsername(), req.GetDuration().AsDuration())
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// UnbanUser is used for lifting the ban of a user.
+func (i *Implementation) UnbanUser(ctx context.Context, req *chatv1.UnbanUserRequest) (*empty.Empty, error) {
+	err := i.chatService.UnbanUser(ctx, req.GetChatId(), req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// MuteUser is used for forbidding a user to send messages to the chat.
+func (i *Implementation) MuteUser(ctx context.Context, req *chatv1.MuteUserRequest) (*empty.Empty, error) {
+	err := i.chatService.MuteUser(ctx, req.GetChatId(), req.GetUsername(), req.GetDuration().AsDuration())
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// UnmuteUser is used for lifting the mute of a user.
+func (i *Implementation) UnmuteUser(ctx context.Context, req *chatv1.UnmuteUserRequest) (*empty.Empty, error) {
+	err := i.chatService.UnmuteUser(ctx, req.GetChatId(), req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
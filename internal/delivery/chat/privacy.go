@@ -0,0 +1,38 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/8thgencore/microservice-chat/internal/converter"
+	"github.com/8thgencore/microservice-chat/internal/model"
+
+	chatv1 "github.com/8thgencore/microservice-chat/pkg/chat/v1"
+)
+
+// ExportUserData is used for answering data subject access requests.
+func (i *Implementation) ExportUserData(
+	req *chatv1.ExportUserDataRequest,
+	stream chatv1.ChatV1_ExportUserDataServer,
+) error {
+	return i.chatService.ExportUserData(stream.Context(), req.GetUsername(), func(record *model.ExportRecord) error {
+		res, err := converter.ToExportUserDataResponseFromService(record)
+		if err != nil {
+			return err
+		}
+
+		return stream.Send(res)
+	})
+}
+
+// EraseUser is used for answering data subject erasure requests.
+func (i *Implementation) EraseUser(
+	ctx context.Context,
+	req *chatv1.EraseUserRequest,
+) (*chatv1.EraseUserResponse, error) {
+	erasure, err := i.chatService.EraseUser(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToEraseUserResponseFromService(erasure), nil
+}
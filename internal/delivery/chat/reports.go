@@ -0,0 +1,51 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/8thgencore/microservice-chat/internal/converter"
+	"github.com/golang/protobuf/ptypes/empty"
+
+	chatv1 "github.com/8thgencore/microservice-chat/pkg/chat/v1"
+)
+
+// ReportMessage is used for reporting a message to the chat moderators.
+func (i *Implementation) ReportMessage(
+	ctx context.Context,
+	req *chatv1.ReportMessageRequest,
+) (*chatv1.ReportMessageResponse, error) {
+	id, err := i.chatService.ReportMessage(ctx, req.GetMessageId(), req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.ReportMessageResponse{
+		Id: id,
+	}, nil
+}
+
+// ListReports is used for listing reports of the chat with the status page by page.
+func (i *Implementation) ListReports(
+	ctx context.Context,
+	req *chatv1.ListReportsRequest,
+) (*chatv1.ListReportsResponse, error) {
+	reports, next, err := i.chatService.ListReports(ctx, converter.ToReportFilterFromDesc(req), req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.ListReportsResponse{
+		Reports:       converter.ToReportsFromService(reports),
+		NextPageToken: next,
+	}, nil
+}
+
+// ResolveReport is used for closing an open report.
+func (i *Implementation) ResolveReport(ctx context.Context, req *chatv1.ResolveReportRequest) (*empty.Empty, error) {
+	err := i.chatService.ResolveReport(ctx, req.GetId(), converter.ToReportStatusFromDesc(req.GetResolution()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,42 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	chatv1 "github.com/8thgencore/microservice-chat/pkg/chat/v1"
+)
+
+// PromoteMember is used for making a chat member an admin.
+func (i *Implementation) PromoteMember(ctx context.Context, req *chatv1.PromoteMemberRequest) (*empty.Empty, error) {
+	err := i.chatService.PromoteMember(ctx, req.GetChatId(), req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// DemoteMember is used for making a chat admin a regular member.
+func (i *Implementation) DemoteMember(ctx context.Context, req *chatv1.DemoteMemberRequest) (*empty.Empty, error) {
+	err := i.chatService.DemoteMember(ctx, req.GetChatId(), req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// TransferOwnership is used for handing the chat over to another member.
+func (i *Implementation) TransferOwnership(
+	ctx context.Context,
+	req *chatv1.TransferOwnershipRequest,
+) (*empty.Empty, error) {
+	err := i.chatService.TransferOwnership(ctx, req.GetChatId(), req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
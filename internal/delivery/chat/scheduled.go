@@ -0,0 +1,55 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/8thgencore/microservice-chat/internal/converter"
+	"github.com/golang/protobuf/ptypes/empty"
+
+	chatv1 "github.com/8thgencore/microservice-chat/pkg/chat/v1"
+)
+
+// ScheduleMessage is used for sending a message to the chat at the given time.
+func (i *Implementation) ScheduleMessage(
+	ctx context.Context,
+	req *chatv1.ScheduleMessageRequest,
+) (*chatv1.ScheduleMessageResponse, error) {
+	id, err := i.chatService.ScheduleMessage(
+		ctx,
+		req.GetChatId(),
+		converter.ToMessageFromDesc(req.GetMessage()),
+		req.GetSendAt().AsTime(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.ScheduleMessageResponse{
+		Id: id,
+	}, nil
+}
+
+// ListScheduled is used for listing messages waiting for delivery in the chat.
+func (i *Implementation) ListScheduled(
+	ctx context.Context,
+	req *chatv1.ListScheduledRequest,
+) (*chatv1.ListScheduledResponse, error) {
+	messages, err := i.chatService.ListScheduled(ctx, req.GetChatId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.ListScheduledResponse{
+		Messages: converter.ToScheduledMessagesFromService(messages),
+	}, nil
+}
+
+// CancelScheduled is used for cancelling a message waiting for delivery.
+func (i *Implementation) CancelScheduled(ctx context.Context, req *chatv1.CancelScheduledRequest) (*empty.Empty, error) {
+	err := i.chatService.CancelScheduled(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -8,12 +8,23 @@ import (
 // Implementation structure describes API layer.
 type Implementation struct {
 	chatv1.UnimplementedChatV1Server
-	chatService service.ChatService
+	chatService   service.ChatService
+	botService    service.BotService
+	auditService  service.AuditService
+	deviceService service.DeviceService
 }
 
 // NewImplementation creates new object of API layer.
-func NewImplementation(chatService service.ChatService) *Implementation {
+func NewImplementation(
+	chatService service.ChatService,
+	botService service.BotService,
+	auditService service.AuditService,
+	deviceService service.DeviceService,
+) *Implementation {
 	return &Implementation{
-		chatService: chatService,
+		chatService:   chatService,
+		botService:    botService,
+		auditService:  auditService,
+		deviceService: deviceService,
 	}
 }
@@ -0,0 +1,56 @@
+// Package filewatch calls back when watched files change.
+package filewatch
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"github.com/8thgencore/microservice-common/pkg/closer"
+	"github.com/8thgencore/microservice-common/pkg/logger"
+	"github.com/fsnotify/fsnotify"
+	"go.uber.org/zap"
+)
+
+// Watch calls onChange after any change in directories of the files until the application is closed.
+// Directories are watched instead of files, so symlink swaps done by Kubernetes volumes are noticed too.
+// Empty paths are ignored.
+func Watch(paths []string, onChange func()) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create file watcher: %w", err)
+	}
+
+	var dirs []string
+	for _, path := range paths {
+		if dir := filepath.Dir(path); path != "" && !slices.Contains(dirs, dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+	for _, dir := range dirs {
+		if err = watcher.Add(dir); err != nil {
+			return errors.Join(fmt.Errorf("failed to watch %s: %w", dir, err), watcher.Close())
+		}
+	}
+	closer.Add(watcher.Close)
+
+	go func() {
+		for {
+			select {
+			case _, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				onChange()
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				logger.Error("file watcher failed", zap.Error(err))
+			}
+		}
+	}()
+
+	return nil
+}
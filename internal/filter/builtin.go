@@ -0,0 +1,166 @@
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// lengthFilter rejects messages longer than the limit.
+type lengthFilter struct {
+	max int
+}
+
+// NewLengthFilter creates filter rejecting messages longer than max characters.
+func NewLengthFilter(max int) MessageFilter {
+	return &lengthFilter{max: max}
+}
+
+func (f *lengthFilter) Check(text string) Verdict {
+	if utf8.RuneCountInString(text) > f.max {
+		return Verdict{
+			Action: ActionReject,
+			Reason: fmt.Sprintf("message is longer than %d characters", f.max),
+		}
+	}
+
+	return Verdict{Action: ActionAccept}
+}
+
+// regexFilter applies the action to messages matching the pattern.
+type regexFilter struct {
+	pattern     *regexp.Regexp
+	action      Action
+	reason      string
+	replacement string
+}
+
+// NewRegexFilter creates filter applying the action to messages matching the pattern.
+// Masking replaces every match with the replacement, or with asterisks if it is empty.
+func NewRegexFilter(pattern *regexp.Regexp, action Action, reason string, replacement string) MessageFilter {
+	return &regexFilter{
+		pattern:     pattern,
+		action:      action,
+		reason:      reason,
+		replacement: replacement,
+	}
+}
+
+func (f *regexFilter) Check(text string) Verdict {
+	if !f.pattern.MatchString(text) {
+		return Verdict{Action: ActionAccept}
+	}
+
+	if f.action == ActionMask {
+		return Verdict{
+			Action: ActionMask,
+			Text: f.pattern.ReplaceAllStringFunc(text, func(match string) string {
+				if f.replacement != "" {
+					return f.replacement
+				}
+				return strings.Repeat("*", utf8.RuneCountInString(match))
+			}),
+		}
+	}
+
+	return Verdict{Action: f.action, Reason: f.reason}
+}
+
+// NewProfanityFilter creates filter applying the action to messages containing any of the words.
+// Words match whole and case-insensitively.
+func NewProfanityFilter(words []string, action Action) MessageFilter {
+	quoted := make([]string, 0, len(words))
+	for _, w := range words {
+		if w = strings.TrimSpace(w); w != "" {
+			quoted = append(quoted, regexp.QuoteMeta(w))
+		}
+	}
+	if len(quoted) == 0 {
+		return acceptFilter{}
+	}
+
+	return &wordFilter{
+		pattern: regexp.MustCompile(`(?i)(?:` + strings.Join(quoted, "|") + `)`),
+		action:  action,
+		reason:  "message contains profanity",
+	}
+}
+
+// wordFilter applies the action to messages containing whole words matching the pattern.
+// RE2 word boundaries only know ASCII letters, so boundaries are checked for letters and digits of any script.
+type wordFilter struct {
+	pattern *regexp.Regexp
+	action  Action
+	reason  string
+}
+
+func (f *wordFilter) Check(text string) Verdict {
+	matches := f.find(text)
+	if len(matches) == 0 {
+		return Verdict{Action: ActionAccept}
+	}
+
+	if f.action != ActionMask {
+		return Verdict{Action: f.action, Reason: f.reason}
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, m := range matches {
+		b.WriteString(text[last:m[0]])
+		b.WriteString(strings.Repeat("*", utf8.RuneCountInString(text[m[0]:m[1]])))
+		last = m[1]
+	}
+	b.WriteString(text[last:])
+
+	return Verdict{Action: ActionMask, Text: b.String()}
+}
+
+// find returns the byte ranges of whole word matches.
+func (f *wordFilter) find(text string) [][2]int {
+	var res [][2]int
+	for pos := 0; pos < len(text); {
+		loc := f.pattern.FindStringIndex(text[pos:])
+		if loc == nil {
+			break
+		}
+		start, end := pos+loc[0], pos+loc[1]
+
+		if end > start && isBoundary(text, start) && isBoundary(text, end) {
+			res = append(res, [2]int{start, end})
+			pos = end
+			continue
+		}
+
+		// A match inside a longer word may hide a whole word starting later
+		_, size := utf8.DecodeRuneInString(text[start:])
+		pos = start + max(size, 1)
+	}
+
+	return res
+}
+
+// isBoundary reports whether the position in the text is not between two word characters.
+func isBoundary(text string, i int) bool {
+	if i == 0 || i == len(text) {
+		return true
+	}
+
+	before, _ := utf8.DecodeLastRuneInString(text[:i])
+	after, _ := utf8.DecodeRuneInString(text[i:])
+
+	return !isWordRune(before) || !isWordRune(after)
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+// acceptFilter accepts every message.
+type acceptFilter struct{}
+
+func (acceptFilter) Check(string) Verdict {
+	return Verdict{Action: ActionAccept}
+}
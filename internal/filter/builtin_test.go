@@ -0,0 +1,73 @@
+package filter
+
+import "testing"
+
+func TestProfanityFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		action Action
+		text   string
+		want   Verdict
+	}{
+		{
+			name:   "clean text",
+			action: ActionReject,
+			text:   "hello there",
+			want:   Verdict{Action: ActionAccept},
+		},
+		{
+			name:   "whole word",
+			action: ActionReject,
+			text:   "this is Bad.",
+			want:   Verdict{Action: ActionReject, Reason: "message contains profanity"},
+		},
+		{
+			name:   "part of a word",
+			action: ActionReject,
+			text:   "badminton and forbad",
+			want:   Verdict{Action: ActionAccept},
+		},
+		{
+			name:   "non-ascii word",
+			action: ActionReject,
+			text:   "это плохо!",
+			want:   Verdict{Action: ActionReject, Reason: "message contains profanity"},
+		},
+		{
+			name:   "part of a non-ascii word",
+			action: ActionReject,
+			text:   "неплохой день",
+			want:   Verdict{Action: ActionAccept},
+		},
+		{
+			name:   "word after non-ascii letters",
+			action: ActionReject,
+			text:   "ébad",
+			want:   Verdict{Action: ActionAccept},
+		},
+		{
+			name:   "mask adjacent words",
+			action: ActionMask,
+			text:   "bad bad,плохо",
+			want:   Verdict{Action: ActionMask, Text: "*** ***,*****"},
+		},
+		{
+			name:   "mask word following a partial match",
+			action: ActionMask,
+			text:   "xbad bad",
+			want:   Verdict{Action: ActionMask, Text: "xbad ***"},
+		},
+	}
+
+	f := func(action Action) MessageFilter {
+		return NewProfanityFilter([]string{"bad", "плохо"}, action)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := f(tt.action).Check(tt.text); got != tt.want {
+				t.Errorf("Check(%q) = %+v, want %+v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
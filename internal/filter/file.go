@@ -0,0 +1,133 @@
+package filter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sync/atomic"
+
+	"github.com/8thgencore/microservice-chat/internal/filewatch"
+	"github.com/8thgencore/microservice-common/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// fileConfig is the filters file, for example:
+//
+//	{
+//	  "max_length": 4000,
+//	  "profanity": {"words": ["darn"], "action": "mask"},
+//	  "rules": [
+//	    {"pattern": "(?i)free crypto", "action": "reject", "reason": "spam"},
+//	    {"pattern": "https?://\\S+", "action": "flag", "reason": "contains a link"}
+//	  ]
+//	}
+//
+// Filters run in the order: length limit, profanity, rules.
+type fileConfig struct {
+	MaxLength int `json:"max_length"`
+	Profanity struct {
+		Words  []string `json:"words"`
+		Action Action   `json:"action"`
+	} `json:"profanity"`
+	Rules []struct {
+		Pattern     string `json:"pattern"`
+		Action      Action `json:"action"`
+		Reason      string `json:"reason"`
+		Replacement string `json:"replacement"`
+	} `json:"rules"`
+}
+
+// LoadChain reads filters from the JSON file.
+func LoadChain(path string) (Chain, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read filters: %w", err)
+	}
+
+	var cfg fileConfig
+	if err = json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse filters: %w", err)
+	}
+
+	var chain Chain
+	if cfg.MaxLength > 0 {
+		chain = append(chain, NewLengthFilter(cfg.MaxLength))
+	}
+
+	if len(cfg.Profanity.Words) > 0 {
+		action := cfg.Profanity.Action
+		if action == "" {
+			action = ActionMask
+		}
+		if err = validateAction(action); err != nil {
+			return nil, fmt.Errorf("profanity filter: %w", err)
+		}
+		chain = append(chain, NewProfanityFilter(cfg.Profanity.Words, action))
+	}
+
+	for n, rule := range cfg.Rules {
+		pattern, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", n, err)
+		}
+		if err = validateAction(rule.Action); err != nil {
+			return nil, fmt.Errorf("rule %d: %w", n, err)
+		}
+		chain = append(chain, NewRegexFilter(pattern, rule.Action, rule.Reason, rule.Replacement))
+	}
+
+	return chain, nil
+}
+
+func validateAction(action Action) error {
+	switch action {
+	case ActionMask, ActionReject, ActionFlag:
+		return nil
+	default:
+		return fmt.Errorf("unknown action %q", action)
+	}
+}
+
+// Reloader is Pipeline of filters from a file, reloaded when the file changes.
+type Reloader struct {
+	path  string
+	chain atomic.Pointer[Chain]
+}
+
+var _ Pipeline = (*Reloader)(nil)
+
+// NewReloader loads filters from the file and watches it.
+func NewReloader(path string) (*Reloader, error) {
+	r := &Reloader{path: path}
+
+	chain, err := LoadChain(path)
+	if err != nil {
+		return nil, err
+	}
+	r.chain.Store(&chain)
+
+	err = filewatch.Watch([]string{path}, r.reload)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Apply implements Pipeline.
+func (r *Reloader) Apply(text string) *Result {
+	return r.chain.Load().Apply(text)
+}
+
+// reload replaces filters, an invalid file keeps the previous ones.
+func (r *Reloader) reload() {
+	chain, err := LoadChain(r.path)
+	if err != nil {
+		logger.Warn("failed to reload filters", zap.Error(err))
+		return
+	}
+
+	r.chain.Store(&chain)
+	logger.Debug("filters reloaded", zap.Int("count", len(chain)))
+}
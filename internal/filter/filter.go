@@ -0,0 +1,72 @@
+// Package filter checks message text before it is stored.
+package filter
+
+// Action is what a filter decided to do with the message.
+type Action string
+
+const (
+	// ActionAccept lets the message through unchanged.
+	ActionAccept Action = "accept"
+	// ActionMask lets the message through with the matched text hidden.
+	ActionMask Action = "mask"
+	// ActionReject refuses the message.
+	ActionReject Action = "reject"
+	// ActionFlag lets the message through and marks it for review by moderators.
+	ActionFlag Action = "flag"
+)
+
+// Verdict is the decision of one filter.
+type Verdict struct {
+	Action Action
+	// Text is the masked text for ActionMask.
+	Text string
+	// Reason explains rejection or flagging.
+	Reason string
+}
+
+// MessageFilter checks message text.
+type MessageFilter interface {
+	Check(text string) Verdict
+}
+
+// Result is the combined decision of filters.
+type Result struct {
+	Text     string
+	Rejected bool
+	Reason   string
+	// Flags are reasons the message should be reviewed for.
+	Flags []string
+}
+
+// Pipeline applies filters to message text.
+type Pipeline interface {
+	Apply(text string) *Result
+}
+
+// Chain runs filters in order.
+// Masked text is passed to the next filters, a rejection stops the chain.
+type Chain []MessageFilter
+
+var _ Pipeline = Chain(nil)
+
+// Apply implements Pipeline.
+func (c Chain) Apply(text string) *Result {
+	res := &Result{Text: text}
+
+	for _, f := range c {
+		v := f.Check(res.Text)
+
+		switch v.Action {
+		case ActionReject:
+			res.Rejected = true
+			res.Reason = v.Reason
+			return res
+		case ActionMask:
+			res.Text = v.Text
+		case ActionFlag:
+			res.Flags = append(res.Flags, v.Reason)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,21 @@
+// Package identity keeps the authenticated caller in the request context.
+package identity
+
+import (
+	"context"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+)
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of the context carrying the caller.
+func WithPrincipal(ctx context.Context, principal *model.Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// FromContext returns the caller stored in the context.
+func FromContext(ctx context.Context) (*model.Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*model.Principal)
+	return principal, ok
+}
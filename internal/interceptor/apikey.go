@@ -0,0 +1,43 @@
+package interceptor
+
+import (
+	"context"
+	"slices"
+
+	"github.com/8thgencore/microservice-chat/internal/identity"
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const apiKeyHeader = "x-api-key"
+
+// apiKeyFromMetadata returns the bot API key of the request, if any.
+func apiKeyFromMetadata(md metadata.MD) string {
+	if values := md.Get(apiKeyHeader); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// authorizeBot authenticates the bot by the API key and checks the endpoint is open to bots.
+func (c *Client) authorizeBot(ctx context.Context, endpoint string, apiKey string) (context.Context, error) {
+	if c.Bots == nil {
+		return nil, status.Error(codes.Unauthenticated, "api keys are not accepted")
+	}
+	if !slices.Contains(c.BotEndpoints, endpoint) {
+		return nil, status.Error(codes.PermissionDenied, "endpoint is not available to bots")
+	}
+
+	bot, err := c.Bots.Authenticate(ctx, apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return identity.WithPrincipal(ctx, &model.Principal{
+		Username: bot.Username(),
+		Kind:     model.PrincipalBot,
+	}), nil
+}
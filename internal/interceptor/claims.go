@@ -0,0 +1,41 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/8thgencore/microservice-auth/pkg/utils"
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// userClaims mirrors the access token claims issued by the auth service.
+type userClaims struct {
+	jwt.RegisteredClaims
+	Username string `json:"username"`
+	Role     string `json:"role"`
+}
+
+// principalFromToken reads the caller from the access token of the request.
+// The signature is not verified here, so it must only be called after the auth client accepted the token.
+func principalFromToken(ctx context.Context) (*model.Principal, error) {
+	token, err := utils.ExtractToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &userClaims{}
+	if _, _, err = jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return nil, fmt.Errorf("failed to parse access token: %w", err)
+	}
+	if claims.Username == "" {
+		return nil, errors.New("access token has no username")
+	}
+
+	return &model.Principal{
+		Username: claims.Username,
+		Role:     claims.Role,
+		Kind:     model.PrincipalUser,
+	}, nil
+}
@@ -3,6 +3,7 @@ package interceptor
 import (
 	"context"
 
+	"github.com/8thgencore/microservice-chat/internal/requestid"
 	"github.com/8thgencore/microservice-common/pkg/logger"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -18,8 +19,33 @@ func LogInterceptor(
 	res, err := handler(ctx, req)
 	// Check the result and log error
 	if err != nil {
-		logger.Error(err.Error(), zap.String("method", info.FullMethod), zap.Any("req", req))
+		logger.Error(
+			err.Error(),
+			zap.String("method", info.FullMethod),
+			zap.String("request_id", requestid.FromContext(ctx)),
+			zap.Any("req", req),
+		)
 	}
 
 	return res, err
 }
+
+// LogStreamInterceptor logs info about streams for gRPC server.
+func LogStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	err := handler(srv, ss)
+	// Check the result and log error
+	if err != nil {
+		logger.Error(
+			err.Error(),
+			zap.String("method", info.FullMethod),
+			zap.String("request_id", requestid.FromContext(ss.Context())),
+		)
+	}
+
+	return err
+}
@@ -5,31 +5,78 @@ import (
 	"errors"
 
 	"github.com/8thgencore/microservice-chat/internal/client/rpc"
+	"github.com/8thgencore/microservice-chat/internal/identity"
+	"github.com/8thgencore/microservice-chat/internal/service"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 // Client contains client connection with authentication service.
 type Client struct {
 	Client rpc.AuthClient
+
+	// Bots authenticates requests with an API key, they may only call BotEndpoints.
+	Bots         service.BotService
+	BotEndpoints []string
 }
 
 // PolicyInterceptor is used for authorization.
+// The caller of an allowed request is put into the context.
 func (c *Client) PolicyInterceptor(
 	ctx context.Context,
 	req interface{},
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler,
 ) (interface{}, error) {
+	ctx, err := c.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// PolicyStreamInterceptor is used for authorization of streams.
+// The caller of an allowed stream is put into the stream context.
+func (c *Client) PolicyStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := c.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &serverStream{
+		ServerStream: ss,
+		ctx:          ctx,
+	})
+}
+
+// authorize checks access to the endpoint and returns the context with the caller.
+func (c *Client) authorize(ctx context.Context, endpoint string) (context.Context, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, errors.New("metadata is not provided")
 	}
 
-	err := c.Client.Check(metadata.NewOutgoingContext(ctx, md), info.FullMethod)
+	if apiKey := apiKeyFromMetadata(md); apiKey != "" {
+		return c.authorizeBot(ctx, endpoint, apiKey)
+	}
+
+	err := c.Client.Check(metadata.NewOutgoingContext(ctx, md), endpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	return handler(ctx, req)
+	principal, err := principalFromToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return identity.WithPrincipal(ctx, principal), nil
 }
@@ -0,0 +1,211 @@
+package interceptor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/config"
+	"github.com/8thgencore/microservice-chat/internal/identity"
+	"github.com/8thgencore/microservice-chat/internal/metrics"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type chatScoped interface {
+	GetChatId() string
+}
+
+type bucketKey struct {
+	method   string
+	username string
+	chatID   string
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// RateLimiter limits calls with token buckets per caller, method and chat,
+// and the number of concurrent server streams per caller.
+// It must run after the policy interceptor, which puts the caller into the context.
+type RateLimiter struct {
+	cfg config.RateLimit
+
+	mu      sync.Mutex
+	buckets map[bucketKey]*bucket
+	streams map[string]int
+}
+
+// NewRateLimiter creates new RateLimiter.
+func NewRateLimiter(cfg config.RateLimit) *RateLimiter {
+	return &RateLimiter{
+		cfg:     cfg,
+		buckets: make(map[bucketKey]*bucket),
+		streams: make(map[string]int),
+	}
+}
+
+// Run drops buckets of inactive callers every IdleTTL until the context is cancelled.
+func (l *RateLimiter) Run(ctx context.Context) {
+	ticker := time.NewTicker(l.cfg.IdleTTL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			l.sweep(now)
+		}
+	}
+}
+
+// RateLimitInterceptor is used to limit the rate of calls.
+func (l *RateLimiter) RateLimitInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if err := l.allow(ctx, info.FullMethod, chatIDOf(req)); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// RateLimitStreamInterceptor is used to limit concurrent server streams and the rate of received messages.
+func (l *RateLimiter) RateLimitStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if info.IsServerStream {
+		release, err := l.acquireStream(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		defer release()
+	}
+
+	// The chat is only known once the request is received
+	return handler(srv, &serverStream{
+		ServerStream: ss,
+		recv: func(m interface{}) error {
+			if err := ss.RecvMsg(m); err != nil {
+				return err
+			}
+
+			return l.allow(ss.Context(), info.FullMethod, chatIDOf(m))
+		},
+	})
+}
+
+func (l *RateLimiter) allow(ctx context.Context, method string, chatID string) error {
+	limit, ok := l.cfg.Methods[method]
+	if !ok {
+		return nil
+	}
+
+	now := time.Now()
+	key := bucketKey{method: method, username: callerOf(ctx), chatID: chatID}
+
+	l.mu.Lock()
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+	r := b.limiter.ReserveN(now, 1)
+	l.mu.Unlock()
+
+	if !r.OK() {
+		metrics.IncRateLimited(method, "rate")
+		return status.Error(codes.ResourceExhausted, "method is disabled by rate limit")
+	}
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		metrics.IncRateLimited(method, "rate")
+		return exhausted("rate limit exceeded", delay)
+	}
+
+	return nil
+}
+
+// acquireStream counts the stream of the caller and returns the function releasing it.
+func (l *RateLimiter) acquireStream(ctx context.Context, method string) (func(), error) {
+	if l.cfg.MaxStreams <= 0 {
+		return func() {}, nil
+	}
+
+	username := callerOf(ctx)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.streams[username] >= l.cfg.MaxStreams {
+		metrics.IncRateLimited(method, "streams")
+		return nil, status.Errorf(codes.ResourceExhausted, "no more than %d streams may be open at once", l.cfg.MaxStreams)
+	}
+	l.streams[username]++
+
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		l.streams[username]--
+		if l.streams[username] <= 0 {
+			delete(l.streams, username)
+		}
+	}, nil
+}
+
+// sweep drops buckets of inactive callers.
+// A bucket idle for IdleTTL is full again, so dropping it does not change the limit.
+func (l *RateLimiter) sweep(now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for key, b := range l.buckets {
+		if now.Sub(b.lastSeen) >= l.cfg.IdleTTL {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// exhausted returns ResourceExhausted error telling the client when to retry.
+func exhausted(msg string, delay time.Duration) error {
+	st := status.New(codes.ResourceExhausted, msg)
+	withDetails, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(delay),
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
+
+func chatIDOf(req interface{}) string {
+	if r, ok := req.(chatScoped); ok {
+		return r.GetChatId()
+	}
+
+	return ""
+}
+
+func callerOf(ctx context.Context) string {
+	if principal, ok := identity.FromContext(ctx); ok {
+		return principal.Username
+	}
+
+	return ""
+}
@@ -0,0 +1,179 @@
+package interceptor
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/config"
+	"github.com/8thgencore/microservice-chat/internal/identity"
+	"github.com/8thgencore/microservice-chat/internal/metrics"
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMain(m *testing.M) {
+	if err := metrics.Init(context.Background()); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+func asCaller(username string) context.Context {
+	return identity.WithPrincipal(context.Background(), &model.Principal{Username: username})
+}
+
+func TestRateLimiterAllow(t *testing.T) {
+	type call struct {
+		username string
+		method   string
+		chatID   string
+	}
+
+	limits := config.MethodLimits{
+		"/send":     {Rate: 1, Burst: 2},
+		"/disabled": {Rate: 0, Burst: 0},
+	}
+
+	tests := []struct {
+		name     string
+		calls    []call
+		wantCode []codes.Code
+	}{
+		{
+			name:     "burst then exhausted",
+			calls:    []call{{"alice", "/send", "a"}, {"alice", "/send", "a"}, {"alice", "/send", "a"}},
+			wantCode: []codes.Code{codes.OK, codes.OK, codes.ResourceExhausted},
+		},
+		{
+			name:     "bucket per caller",
+			calls:    []call{{"alice", "/send", "a"}, {"alice", "/send", "a"}, {"bob", "/send", "a"}},
+			wantCode: []codes.Code{codes.OK, codes.OK, codes.OK},
+		},
+		{
+			name:     "bucket per chat",
+			calls:    []call{{"alice", "/send", "a"}, {"alice", "/send", "a"}, {"alice", "/send", "b"}},
+			wantCode: []codes.Code{codes.OK, codes.OK, codes.OK},
+		},
+		{
+			name:     "unlimited method",
+			calls:    []call{{"alice", "/other", "a"}, {"alice", "/other", "a"}, {"alice", "/other", "a"}},
+			wantCode: []codes.Code{codes.OK, codes.OK, codes.OK},
+		},
+		{
+			name:     "disabled method",
+			calls:    []call{{"alice", "/disabled", "a"}},
+			wantCode: []codes.Code{codes.ResourceExhausted},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewRateLimiter(config.RateLimit{Methods: limits})
+
+			for i, c := range tt.calls {
+				err := l.allow(asCaller(c.username), c.method, c.chatID)
+				if code := status.Code(err); code != tt.wantCode[i] {
+					t.Errorf("call %d: code = %v, want %v", i, code, tt.wantCode[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimiterRetryDelay(t *testing.T) {
+	l := NewRateLimiter(config.RateLimit{Methods: config.MethodLimits{"/send": {Rate: 1, Burst: 1}}})
+	ctx := asCaller("alice")
+
+	if err := l.allow(ctx, "/send", "a"); err != nil {
+		t.Fatalf("allow() error = %v", err)
+	}
+
+	var delay time.Duration
+	for _, detail := range status.Convert(l.allow(ctx, "/send", "a")).Details() {
+		if retry, ok := detail.(*errdetails.RetryInfo); ok {
+			delay = retry.GetRetryDelay().AsDuration()
+		}
+	}
+	if delay <= 0 || delay > time.Second {
+		t.Errorf("retry delay = %v, want within (0, 1s]", delay)
+	}
+}
+
+func TestRateLimiterStreams(t *testing.T) {
+	l := NewRateLimiter(config.RateLimit{MaxStreams: 2})
+
+	first, err := l.acquireStream(asCaller("alice"), "/listen")
+	if err != nil {
+		t.Fatalf("first stream: error = %v", err)
+	}
+	if _, err = l.acquireStream(asCaller("alice"), "/listen"); err != nil {
+		t.Fatalf("second stream: error = %v", err)
+	}
+	if _, err = l.acquireStream(asCaller("alice"), "/listen"); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("third stream: code = %v, want %v", status.Code(err), codes.ResourceExhausted)
+	}
+	if _, err = l.acquireStream(asCaller("bob"), "/listen"); err != nil {
+		t.Errorf("stream of another caller: error = %v", err)
+	}
+
+	first()
+	if _, err = l.acquireStream(asCaller("alice"), "/listen"); err != nil {
+		t.Errorf("stream after release: error = %v", err)
+	}
+}
+
+// testServerStream is a server stream without messages.
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestRateLimitStreamInterceptorReleases(t *testing.T) {
+	l := NewRateLimiter(config.RateLimit{MaxStreams: 1})
+	ss := &testServerStream{ctx: asCaller("alice")}
+	info := &grpc.StreamServerInfo{FullMethod: "/listen", IsServerStream: true}
+
+	err := l.RateLimitStreamInterceptor(nil, ss, info, func(_ interface{}, _ grpc.ServerStream) error {
+		// The open stream counts against the limit
+		_, err := l.acquireStream(ss.Context(), "/listen")
+		if status.Code(err) != codes.ResourceExhausted {
+			t.Errorf("stream while open: code = %v, want %v", status.Code(err), codes.ResourceExhausted)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("RateLimitStreamInterceptor() error = %v", err)
+	}
+
+	if len(l.streams) != 0 {
+		t.Errorf("streams = %v, want none after the handler returned", l.streams)
+	}
+}
+
+func TestRateLimiterSweep(t *testing.T) {
+	now := time.Now()
+	l := NewRateLimiter(config.RateLimit{IdleTTL: time.Minute})
+
+	idle := bucketKey{method: "/send", username: "alice"}
+	fresh := bucketKey{method: "/send", username: "bob"}
+	l.buckets[idle] = &bucket{lastSeen: now.Add(-time.Minute)}
+	l.buckets[fresh] = &bucket{lastSeen: now.Add(-time.Second)}
+
+	l.sweep(now)
+
+	if _, ok := l.buckets[idle]; ok {
+		t.Error("idle bucket is kept")
+	}
+	if _, ok := l.buckets[fresh]; !ok {
+		t.Error("fresh bucket is dropped")
+	}
+}
@@ -0,0 +1,55 @@
+package interceptor
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/8thgencore/microservice-chat/internal/requestid"
+)
+
+const requestIDHeader = "x-request-id"
+
+// Request IDs of clients are accepted only if they are safe to put in logs.
+var requestIDRegexp = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// RequestIDInterceptor keeps the request ID sent by the client or a new one in the context
+// and returns it in the response header.
+func RequestIDInterceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	id := incomingRequestID(ctx)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, id))
+
+	return handler(requestid.With(ctx, id), req)
+}
+
+// RequestIDStreamInterceptor is RequestIDInterceptor for streams.
+func RequestIDStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	id := incomingRequestID(ss.Context())
+	_ = ss.SetHeader(metadata.Pairs(requestIDHeader, id))
+
+	return handler(srv, &serverStream{ServerStream: ss, ctx: requestid.With(ss.Context(), id)})
+}
+
+func incomingRequestID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		if values := md.Get(requestIDHeader); len(values) > 0 && requestIDRegexp.MatchString(values[0]) {
+			return values[0]
+		}
+	}
+
+	return uuid.NewString()
+}
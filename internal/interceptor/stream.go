@@ -0,0 +1,28 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// serverStream overrides the context and received messages of gRPC server stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	recv func(m interface{}) error
+}
+
+func (s *serverStream) Context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return s.ServerStream.Context()
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	if s.recv != nil {
+		return s.recv(m)
+	}
+	return s.ServerStream.RecvMsg(m)
+}
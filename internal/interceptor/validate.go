@@ -2,14 +2,36 @@ package interceptor
 
 import (
 	"context"
+	"strings"
+	"unicode"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type validator interface {
 	Validate() error
 }
 
+// allValidator is implemented by messages generated by protoc-gen-validate, it reports every violation at once.
+type allValidator interface {
+	ValidateAll() error
+}
+
+// fieldError is implemented by violations generated by protoc-gen-validate.
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// multiError is implemented by the error returned from ValidateAll.
+type multiError interface {
+	AllErrors() []error
+}
+
 // ValidateInterceptor is used to validate requests for gRPC server.
 func ValidateInterceptor(
 	ctx context.Context,
@@ -17,11 +39,103 @@ func ValidateInterceptor(
 	_ *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler,
 ) (interface{}, error) {
-	if val, ok := req.(validator); ok {
-		if err := val.Validate(); err != nil {
-			return nil, err
-		}
+	if err := validate(req); err != nil {
+		return nil, err
 	}
 
 	return handler(ctx, req)
 }
+
+// ValidateStreamInterceptor is used to validate every message received from a stream for gRPC server.
+// Requests of client streams are validated by the handler, so that one bad request does not end the stream.
+func ValidateStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if info.IsClientStream {
+		return handler(srv, ss)
+	}
+
+	return handler(srv, &serverStream{
+		ServerStream: ss,
+		recv: func(m interface{}) error {
+			if err := ss.RecvMsg(m); err != nil {
+				return err
+			}
+
+			return validate(m)
+		},
+	})
+}
+
+// validate checks the message rules and reports violations as InvalidArgument with BadRequest details.
+func validate(m interface{}) error {
+	var err error
+	switch val := m.(type) {
+	case allValidator:
+		err = val.ValidateAll()
+	case validator:
+		err = val.Validate()
+	}
+	if err == nil {
+		return nil
+	}
+
+	st, errDetails := status.New(codes.InvalidArgument, err.Error()).WithDetails(&errdetails.BadRequest{
+		FieldViolations: fieldViolations("", err),
+	})
+	if errDetails != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return st.Err()
+}
+
+// fieldViolations flattens violations of nested messages into field paths such as "chat.usernames[1]".
+func fieldViolations(prefix string, err error) []*errdetails.BadRequest_FieldViolation {
+	if multi, ok := err.(multiError); ok {
+		var res []*errdetails.BadRequest_FieldViolation
+		for _, e := range multi.AllErrors() {
+			res = append(res, fieldViolations(prefix, e)...)
+		}
+		return res
+	}
+
+	violation, ok := err.(fieldError)
+	if !ok {
+		return []*errdetails.BadRequest_FieldViolation{{Field: prefix, Description: err.Error()}}
+	}
+
+	field := fieldPath(violation.Field())
+	if prefix != "" {
+		field = prefix + "." + field
+	}
+
+	// Embedded messages report their own violations as the cause
+	switch cause := violation.Cause().(type) {
+	case multiError, fieldError:
+		return fieldViolations(field, cause)
+	}
+
+	return []*errdetails.BadRequest_FieldViolation{{Field: field, Description: violation.Reason()}}
+}
+
+// fieldPath converts the Go field name reported by protoc-gen-validate to the proto one, e.g. "ChatId" to "chat_id".
+func fieldPath(name string) string {
+	var b strings.Builder
+	prev := rune(0)
+	for _, r := range name {
+		if unicode.IsUpper(r) {
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+		prev = r
+	}
+
+	return b.String()
+}
@@ -0,0 +1,92 @@
+package interceptor
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	chatv1 "github.com/8thgencore/microservice-chat/pkg/chat/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFieldPath(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "Text", want: "text"},
+		{name: "ChatId", want: "chat_id"},
+		{name: "ClientMessageId", want: "client_message_id"},
+		{name: "Usernames[1]", want: "usernames[1]"},
+		{name: "Sha256Sum", want: "sha256_sum"},
+		{name: "V2", want: "v2"},
+		{name: "text", want: "text"},
+		{name: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fieldPath(tt.name); got != tt.want {
+				t.Errorf("fieldPath(%q) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateFieldViolations(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        interface{}
+		wantFields []string
+	}{
+		{
+			name:       "valid",
+			req:        &chatv1.SendMessageRequest{ChatId: "1c4a7b5e-2f3d-4e6a-9b8c-0d1e2f3a4b5c", Message: &chatv1.Message{}},
+			wantFields: nil,
+		},
+		{
+			name: "top level and nested fields",
+			req: &chatv1.SendMessageRequest{
+				ChatId:  "chat",
+				Message: &chatv1.Message{ClientMessageId: strings.Repeat("x", 129)},
+			},
+			wantFields: []string{"chat_id", "message.client_message_id"},
+		},
+		{
+			name:       "repeated item",
+			req:        &chatv1.CreateRequest{Chat: &chatv1.Chat{Usernames: []string{"alice", ""}}},
+			wantFields: []string{"chat.usernames[1]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validate(tt.req)
+			if tt.wantFields == nil {
+				if err != nil {
+					t.Fatalf("validate() error = %v", err)
+				}
+				return
+			}
+
+			st := status.Convert(err)
+			if st.Code() != codes.InvalidArgument {
+				t.Fatalf("code = %v, want %v", st.Code(), codes.InvalidArgument)
+			}
+
+			var fields []string
+			for _, detail := range st.Details() {
+				if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+					for _, v := range badRequest.GetFieldViolations() {
+						fields = append(fields, v.GetField())
+					}
+				}
+			}
+			if !slices.Equal(fields, tt.wantFields) {
+				t.Errorf("field violations = %v, want %v", fields, tt.wantFields)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	namespace = "chat"
+	appName   = "chat_service"
+)
+
+// Metrics contains application metrics.
+type Metrics struct {
+	authCacheRequests *prometheus.CounterVec
+	authCacheEntries  prometheus.Gauge
+	rateLimited       *prometheus.CounterVec
+	spamDetected      *prometheus.CounterVec
+	spamStoreErrors   prometheus.Counter
+}
+
+var metrics *Metrics
+
+// Init creates metrics object for metrics operations.
+func Init(_ context.Context) error {
+	metrics = &Metrics{
+		authCacheRequests: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "auth_cache",
+				Name:      appName + "_requests_total",
+				Help:      "Number of access checks by cache result: hit, miss or shared",
+			},
+			[]string{"result"},
+		),
+		authCacheEntries: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "auth_cache",
+				Name:      appName + "_entries",
+				Help:      "Number of access decisions in cache",
+			},
+		),
+		rateLimited: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "rate_limit",
+				Name:      appName + "_rejected_total",
+				Help:      "Number of calls rejected by rate limits: rate or streams",
+			},
+			[]string{"method", "limit"},
+		),
+		spamDetected: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "spam",
+				Name:      appName + "_detected_total",
+				Help:      "Number of messages detected as spam by rule and action taken",
+			},
+			[]string{"rule", "action"},
+		),
+		spamStoreErrors: promauto.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "spam",
+				Name:      appName + "_store_errors_total",
+				Help:      "Number of messages allowed unchecked because the spam window store failed",
+			},
+		),
+	}
+
+	return nil
+}
+
+// IncAuthCacheRequests increases number of access checks labelling with cache result.
+func IncAuthCacheRequests(result string) {
+	metrics.authCacheRequests.WithLabelValues(result).Inc()
+}
+
+// SetAuthCacheEntries sets number of access decisions in cache.
+func SetAuthCacheEntries(n int) {
+	metrics.authCacheEntries.Set(float64(n))
+}
+
+// IncRateLimited increases number of calls rejected by the limit.
+func IncRateLimited(method string, limit string) {
+	metrics.rateLimited.WithLabelValues(method, limit).Inc()
+}
+
+// IncSpamDetected increases number of messages detected as spam.
+func IncSpamDetected(rule string, action string) {
+	metrics.spamDetected.WithLabelValues(rule, action).Inc()
+}
+
+// IncSpamStoreErrors increases number of messages not checked for spam.
+func IncSpamStoreErrors() {
+	metrics.spamStoreErrors.Inc()
+}
@@ -0,0 +1,10 @@
+package model
+
+import "time"
+
+// Block type is a user hiding another user's messages and refusing direct chats with them.
+type Block struct {
+	Blocker   string
+	Blocked   string
+	CreatedAt time.Time
+}
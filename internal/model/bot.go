@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// BotUsernamePrefix starts usernames of bots, so they never clash with users of the auth service.
+const BotUsernamePrefix = "bot:"
+
+// Bot type is a service account posting into chats with an API key.
+type Bot struct {
+	ID           string
+	Name         string
+	CreatedBy    string
+	CreatedAt    time.Time
+	KeyRotatedAt time.Time
+	RevokedAt    *time.Time
+}
+
+// Username returns the name the bot is a chat member and message author as.
+func (b *Bot) Username() string {
+	return BotUsernamePrefix + b.Name
+}
@@ -6,17 +6,64 @@ import (
 	chatv1 "github.com/8thgencore/microservice-chat/pkg/chat/v1"
 )
 
+// EventType describes what happened to the message sent to the stream.
+type EventType int
+
+const (
+	// EventMessage is a new message in the chat.
+	EventMessage EventType = iota
+	// EventDeleted is a deletion of the message with the given ID.
+	EventDeleted
+	// EventReported is a new report of the message, sent to chat admins only.
+	EventReported
+	// EventReportResolved is a resolution of the report, sent to chat admins only.
+	EventReportResolved
+)
+
+// ModeratorsOnly reports whether the event is only sent to chat admins.
+func (e EventType) ModeratorsOnly() bool {
+	return e == EventReported || e == EventReportResolved
+}
+
+// RetentionForever is the retention of chats opted out of the default one, their messages are kept forever.
+const RetentionForever time.Duration = -1
+
 // Chat type is the main structure for chat.
 type Chat struct {
 	ID        string
 	Usernames []string
+	// MessageTTL is the default lifetime of messages in the chat, zero means forever.
+	MessageTTL time.Duration
+	// Retention is how long messages are kept in the chat, zero means global default.
+	// RetentionForever keeps them forever.
+	Retention time.Duration
+	// E2E marks end-to-end encrypted chats: messages only carry device payloads the server can not read.
+	E2E bool
 }
 
 // Message type is the main structure for user message.
 type Message struct {
+	ID        string
+	ChatID    string
 	From      string
 	Text      string
 	Timestamp time.Time
+	// TTL is the lifetime of the message requested by the sender, zero means chat default.
+	TTL time.Duration
+	// ExpiresAt is the moment when the message is deleted, zero means never.
+	ExpiresAt time.Time
+	Event     EventType
+	// ClientMessageID is the sender generated key deduplicating retried messages.
+	ClientMessageID string
+	// Report is the report of the message for report events.
+	Report *Report
+	// Payloads are the message encrypted for each recipient device in end-to-end encrypted chats.
+	Payloads []*DevicePayload
+}
+
+// Expired reports whether the message lifetime is over.
+func (m *Message) Expired(now time.Time) bool {
+	return !m.ExpiresAt.IsZero() && !m.ExpiresAt.After(now)
 }
 
 // Stream is the wrapper for gRPC stream interface.
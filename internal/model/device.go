@@ -0,0 +1,37 @@
+package model
+
+import "time"
+
+// DeviceKey type is the public key of a user's device which end-to-end encrypted messages are addressed to.
+type DeviceKey struct {
+	ID        string
+	Username  string
+	DeviceID  string
+	PublicKey []byte
+	CreatedAt time.Time
+}
+
+// DevicePayload type is a message encrypted for one device of the recipient.
+// The server stores and forwards the ciphertext without inspecting it.
+type DevicePayload struct {
+	Recipient  string
+	DeviceID   string
+	Ciphertext []byte
+}
+
+// ForRecipient returns a copy of the message carrying only the payloads addressed to the user.
+func (m *Message) ForRecipient(username string) *Message {
+	if len(m.Payloads) == 0 {
+		return m
+	}
+
+	res := *m
+	res.Payloads = make([]*DevicePayload, 0, len(m.Payloads))
+	for _, p := range m.Payloads {
+		if p.Recipient == username {
+			res.Payloads = append(res.Payloads, p)
+		}
+	}
+
+	return &res
+}
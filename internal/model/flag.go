@@ -0,0 +1,8 @@
+package model
+
+// MessageFlag type marks a message for review by moderators.
+type MessageFlag struct {
+	MessageID string
+	ChatID    string
+	Reason    string
+}
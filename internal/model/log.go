@@ -1,6 +1,192 @@
 package model
 
+import (
+	"maps"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Audit actions recorded in the transaction log.
+const (
+	ActionChatCreate       = "chat.create"
+	ActionChatDelete       = "chat.delete"
+	ActionChatSetTTL       = "chat.set_message_ttl"
+	ActionChatSetRetention = "chat.set_retention"
+	ActionChatPurge        = "chat.purge"
+	ActionMemberSetRole    = "member.set_role"
+	ActionMemberBan        = "member.ban"
+	ActionMemberUnban      = "member.unban"
+	ActionMemberMute       = "member.mute"
+	ActionMemberUnmute     = "member.unmute"
+	ActionMessageSend      = "message.send"
+	ActionMessageSchedule  = "message.schedule"
+	ActionMessageCancel    = "message.cancel_scheduled"
+	ActionMessageImport    = "message.import"
+	ActionMessageExpire    = "message.expire"
+	ActionMessageReport    = "message.report"
+	ActionReportResolve    = "report.resolve"
+	ActionUserBlock        = "user.block"
+	ActionUserUnblock      = "user.unblock"
+	ActionUserExport       = "user.export"
+	ActionUserErase        = "user.erase"
+	ActionBotCreate        = "bot.create"
+	ActionBotRotateKey     = "bot.rotate_key"
+	ActionBotRevoke        = "bot.revoke"
+	ActionDeviceRegister   = "device.register"
+	ActionDeviceRevoke     = "device.revoke"
+)
+
+// SystemActor is the actor of changes made by background workers and automatic moderation.
+const SystemActor = "system"
+
 // Log type is the main structure for transaction log record.
 type Log struct {
+	ID        string
+	Timestamp time.Time
+	// Actor and RequestID are taken from the request context if not set.
+	Actor     string
+	Action    string
+	ChatID    string
+	MessageID string
+	RequestID string
+	// Payload holds the details of the action, it is stored as JSON.
+	Payload map[string]any
+	// Text is the human readable summary of the action.
 	Text string
 }
+
+// LogFilter selects transaction log records, zero fields match any record.
+type LogFilter struct {
+	Actor  string
+	Action string
+	ChatID string
+	From   time.Time
+	To     time.Time
+	Limit  uint64
+	// Before continues a listing after the given record, records are listed newest first.
+	Before *LogCursor
+}
+
+// LogCursor is the position of a record in the newest first order.
+type LogCursor struct {
+	Timestamp time.Time
+	ID        string
+}
+
+// Anonymize replaces the username with the pseudonym wherever the record mentions the user:
+// as the actor, in payload values and in the text. It reports whether the record was changed.
+// The payload is replaced rather than modified, so copies of the record are not affected.
+func (l *Log) Anonymize(username string, pseudonym string) bool {
+	changed := false
+
+	if l.Actor == username {
+		l.Actor = pseudonym
+		changed = true
+	}
+
+	if payload, ok := anonymizeValue(l.Payload, username, pseudonym); ok {
+		l.Payload = payload.(map[string]any)
+		changed = true
+	}
+
+	if text, ok := replaceName(l.Text, username, pseudonym); ok {
+		l.Text = text
+		changed = true
+	}
+
+	return changed
+}
+
+// anonymizeValue returns a copy of the payload value with the username replaced, if the value holds it.
+func anonymizeValue(value any, username string, pseudonym string) (any, bool) {
+	switch v := value.(type) {
+	case string:
+		if v == username {
+			return pseudonym, true
+		}
+	case []string:
+		if slices.Contains(v, username) {
+			res := slices.Clone(v)
+			for i := range res {
+				if res[i] == username {
+					res[i] = pseudonym
+				}
+			}
+			return res, true
+		}
+	case []any:
+		var res []any
+		for i, item := range v {
+			if replaced, ok := anonymizeValue(item, username, pseudonym); ok {
+				if res == nil {
+					res = slices.Clone(v)
+				}
+				res[i] = replaced
+			}
+		}
+		if res != nil {
+			return res, true
+		}
+	case map[string]any:
+		var res map[string]any
+		for key, item := range v {
+			if replaced, ok := anonymizeValue(item, username, pseudonym); ok {
+				if res == nil {
+					res = maps.Clone(v)
+				}
+				res[key] = replaced
+			}
+		}
+		if res != nil {
+			return res, true
+		}
+	}
+
+	return value, false
+}
+
+// replaceName replaces the username in the text where it is not a part of a longer name or an ID.
+func replaceName(text string, username string, pseudonym string) (string, bool) {
+	if username == "" {
+		return text, false
+	}
+
+	var b strings.Builder
+	changed := false
+	last := 0
+	for start := 0; ; {
+		i := strings.Index(text[start:], username)
+		if i < 0 {
+			break
+		}
+		i += start
+		end := i + len(username)
+		start = i + 1
+
+		before, _ := utf8.DecodeLastRuneInString(text[:i])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if (i > 0 && isNameRune(before)) || (end < len(text) && isNameRune(after)) {
+			continue
+		}
+
+		b.WriteString(text[last:i])
+		b.WriteString(pseudonym)
+		last = end
+		start = end
+		changed = true
+	}
+
+	if !changed {
+		return text, false
+	}
+	b.WriteString(text[last:])
+
+	return b.String(), true
+}
+
+func isNameRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-' || r == '.'
+}
@@ -0,0 +1,42 @@
+package model
+
+import "time"
+
+// ChatRole is the role of a member within a chat.
+type ChatRole string
+
+const (
+	// ChatRoleOwner is the single member who fully controls the chat.
+	ChatRoleOwner ChatRole = "owner"
+	// ChatRoleAdmin is a member who manages chat settings and members.
+	ChatRoleAdmin ChatRole = "admin"
+	// ChatRoleMember is a regular member.
+	ChatRoleMember ChatRole = "member"
+)
+
+// Member type is a member of a chat with the role.
+type Member struct {
+	ChatID   string
+	Username string
+	Role     ChatRole
+	// JoinedAt is only set for memberships listed by user.
+	JoinedAt time.Time
+}
+
+// AtLeast reports whether the role grants everything the other role does.
+func (r ChatRole) AtLeast(other ChatRole) bool {
+	return r.rank() >= other.rank()
+}
+
+func (r ChatRole) rank() int {
+	switch r {
+	case ChatRoleOwner:
+		return 2
+	case ChatRoleAdmin:
+		return 1
+	case ChatRoleMember:
+		return 0
+	default:
+		return -1
+	}
+}
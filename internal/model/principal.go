@@ -0,0 +1,31 @@
+package model
+
+// RoleAdmin is the role of service administrators issued by the auth service.
+const RoleAdmin = "ADMIN"
+
+// PrincipalKind tells how the caller was authenticated.
+type PrincipalKind string
+
+const (
+	// PrincipalUser is a user authenticated with an access token of the auth service.
+	PrincipalUser PrincipalKind = "user"
+	// PrincipalBot is a bot account authenticated with an API key.
+	PrincipalBot PrincipalKind = "bot"
+)
+
+// Principal type is the authenticated caller of the request.
+type Principal struct {
+	Username string
+	Role     string
+	Kind     PrincipalKind
+}
+
+// IsAdmin reports whether the caller is a service administrator.
+func (p *Principal) IsAdmin() bool {
+	return p != nil && p.Kind != PrincipalBot && p.Role == RoleAdmin
+}
+
+// IsBot reports whether the caller is a bot account.
+func (p *Principal) IsBot() bool {
+	return p != nil && p.Kind == PrincipalBot
+}
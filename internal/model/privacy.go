@@ -0,0 +1,43 @@
+package model
+
+import "time"
+
+// ErasedUsernamePrefix starts pseudonyms which replace erased users, e.g. as authors of their messages.
+const ErasedUsernamePrefix = "erased:"
+
+// ExportKind is the kind of a user data export record.
+type ExportKind string
+
+const (
+	// ExportUser opens the export with the user and the export time.
+	ExportUser ExportKind = "user"
+	// ExportMembership is a chat the user is a member of.
+	ExportMembership ExportKind = "membership"
+	// ExportMessage is a message authored by the user.
+	ExportMessage ExportKind = "message"
+	// ExportBlock is a user blocked by the user.
+	ExportBlock ExportKind = "block"
+	// ExportDeviceKey is a public key of the user's device.
+	ExportDeviceKey ExportKind = "device_key"
+)
+
+// ExportRecord type is one record of a user data export, only the field of its kind is set.
+type ExportRecord struct {
+	Kind       ExportKind
+	Username   string
+	ExportedAt time.Time
+	Membership *Member
+	Message    *Message
+	Block      *Block
+	DeviceKey  *DeviceKey
+}
+
+// Erasure type is the outcome of erasing a user.
+type Erasure struct {
+	Username string
+	// Pseudonym replaces the user as author of the kept messages.
+	Pseudonym string
+	// ChatIDs are the chats the user was removed from.
+	ChatIDs  []string
+	Messages int
+}
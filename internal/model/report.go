@@ -0,0 +1,49 @@
+package model
+
+import "time"
+
+// ReportStatus is the state of a message report.
+type ReportStatus string
+
+const (
+	// ReportOpen is a report waiting for a moderator.
+	ReportOpen ReportStatus = "open"
+	// ReportDismissed is a report closed without action.
+	ReportDismissed ReportStatus = "dismissed"
+	// ReportMessageDeleted is a report closed by deleting the message.
+	ReportMessageDeleted ReportStatus = "message_deleted"
+	// ReportAuthorBanned is a report closed by banning the author in the chat.
+	ReportAuthorBanned ReportStatus = "author_banned"
+)
+
+// Report type is a complaint about a message.
+// The message author is copied, so the author can be banned after the message is gone.
+// The text is read from the message and is empty once the message is deleted.
+type Report struct {
+	ID          string
+	ChatID      string
+	MessageID   string
+	MessageFrom string
+	MessageText string
+	Reporter    string
+	Reason      string
+	Status      ReportStatus
+	ResolvedBy  string
+	CreatedAt   time.Time
+	ResolvedAt  *time.Time
+}
+
+// ReportFilter selects reports of the chat with the status.
+type ReportFilter struct {
+	ChatID string
+	Status ReportStatus
+	Limit  uint64
+	// After continues a listing after the given report, reports are listed oldest first.
+	After *ReportCursor
+}
+
+// ReportCursor is the position of a report in the oldest first order.
+type ReportCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// RestrictionKind is a kind of moderation restriction in a chat.
+type RestrictionKind string
+
+const (
+	// RestrictionBan keeps the user out of the chat.
+	RestrictionBan RestrictionKind = "ban"
+	// RestrictionMute lets the user read the chat but not send messages.
+	RestrictionMute RestrictionKind = "mute"
+)
+
+// Restriction type is a ban or mute of a user in a chat.
+type Restriction struct {
+	ChatID    string
+	Username  string
+	Kind      RestrictionKind
+	ExpiresAt *time.Time
+	CreatedBy string
+}
+
+// Active reports whether the restriction is still in effect.
+func (r *Restriction) Active(now time.Time) bool {
+	return r.ExpiresAt == nil || now.Before(*r.ExpiresAt)
+}
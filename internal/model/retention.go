@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+)
+
+// PurgeOptions type describes how a retention purge run is performed.
+type PurgeOptions struct {
+	// DefaultRetention applies to chats without own retention, zero keeps messages forever.
+	DefaultRetention time.Duration
+	BatchSize        uint64
+	// DryRun only counts messages which would be deleted.
+	DryRun bool
+}
+
+// PurgeReport type is the result of a retention purge run.
+type PurgeReport struct {
+	DryRun bool
+	// Deleted is the number of deleted messages, or messages to delete in dry-run mode.
+	Deleted int
+	// Chats is the number of deleted messages per chat ID.
+	Chats map[string]int
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+)
+
+// ScheduledStatus describes the delivery state of a scheduled message.
+type ScheduledStatus string
+
+const (
+	// ScheduledPending is the status of a message waiting for delivery.
+	ScheduledPending ScheduledStatus = "pending"
+	// ScheduledSent is the status of a delivered message.
+	ScheduledSent ScheduledStatus = "sent"
+	// ScheduledCancelled is the status of a message cancelled by the user.
+	ScheduledCancelled ScheduledStatus = "cancelled"
+	// ScheduledFailed is the status of a message which ran out of delivery attempts.
+	ScheduledFailed ScheduledStatus = "failed"
+)
+
+// ScheduledMessage type is the main structure for message with delayed delivery.
+type ScheduledMessage struct {
+	ID       string
+	ChatID   string
+	Message  *Message
+	SendAt   time.Time
+	Status   ScheduledStatus
+	Attempts int
+}
@@ -0,0 +1,60 @@
+// Package pagination encodes page tokens of listings ordered by time.
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultPageSize is the size of a page if the request does not set one.
+	DefaultPageSize = 50
+	// MaxPageSize is the largest page a request can ask for.
+	MaxPageSize = 500
+)
+
+// PageSize returns the requested page size within the limits, zero selects the default one.
+func PageSize(requested uint64) uint64 {
+	switch {
+	case requested == 0:
+		return DefaultPageSize
+	case requested > MaxPageSize:
+		return MaxPageSize
+	default:
+		return requested
+	}
+}
+
+// EncodeCursor returns the page token continuing a listing after the record with the timestamp and ID.
+// Page tokens are opaque to clients, they hold the position of the last returned record.
+func EncodeCursor(timestamp time.Time, id string) string {
+	raw := timestamp.UTC().Format(time.RFC3339Nano) + "|" + id
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor returns the timestamp and ID of the record the page token continues after.
+func DecodeCursor(token string) (time.Time, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+
+	ts, id, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return time.Time{}, "", errors.New("malformed page token")
+	}
+
+	timestamp, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+	if _, err = uuid.Parse(id); err != nil {
+		return time.Time{}, "", err
+	}
+
+	return timestamp, id, nil
+}
@@ -0,0 +1,20 @@
+package converter
+
+import (
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository/blocks/dao"
+)
+
+// ToBlocksFromRepo converts repository layer models to structures of service layer.
+func ToBlocksFromRepo(blocks []*dao.Block) []*model.Block {
+	res := make([]*model.Block, 0, len(blocks))
+	for _, b := range blocks {
+		res = append(res, &model.Block{
+			Blocker:   b.Blocker,
+			Blocked:   b.Blocked,
+			CreatedAt: b.CreatedAt,
+		})
+	}
+
+	return res
+}
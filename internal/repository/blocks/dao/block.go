@@ -0,0 +1,10 @@
+package dao
+
+import "time"
+
+// Block type is the main structure for user block.
+type Block struct {
+	Blocker   string    `db:"blocker"`
+	Blocked   string    `db:"blocked"`
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -0,0 +1,188 @@
+package blocks
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"github.com/8thgencore/microservice-chat/internal/repository/blocks/converter"
+	"github.com/8thgencore/microservice-chat/internal/repository/blocks/dao"
+	"github.com/8thgencore/microservice-common/pkg/db"
+)
+
+const (
+	tableName = "user_blocks"
+
+	blockerColumn   = "blocker"
+	blockedColumn   = "blocked"
+	createdAtColumn = "created_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new object of repository layer.
+func NewRepository(db db.Client) repository.BlocksRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Create(ctx context.Context, blocker string, blocked string) error {
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(blockerColumn, blockedColumn).
+		Values(blocker, blocked).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "blocks_repository.Create",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return repository.ErrAlreadyExists
+	}
+
+	return nil
+}
+
+func (r *repo) Delete(ctx context.Context, blocker string, blocked string) error {
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{blockerColumn: blocker, blockedColumn: blocked})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "blocks_repository.Delete",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *repo) List(ctx context.Context, blocker string) ([]*model.Block, error) {
+	builderSelect := sq.Select(blockerColumn, blockedColumn, createdAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{blockerColumn: blocker}).
+		OrderBy(createdAtColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "blocks_repository.List",
+		QueryRaw: query,
+	}
+
+	var blocks []*dao.Block
+	err = r.db.DB().ScanAllContext(ctx, &blocks, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToBlocksFromRepo(blocks), nil
+}
+
+func (r *repo) ListByBlockers(ctx context.Context, blockers []string) ([]*model.Block, error) {
+	builderSelect := sq.Select(blockerColumn, blockedColumn, createdAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{blockerColumn: blockers})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "blocks_repository.ListByBlockers",
+		QueryRaw: query,
+	}
+
+	var blocks []*dao.Block
+	err = r.db.DB().ScanAllContext(ctx, &blocks, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToBlocksFromRepo(blocks), nil
+}
+
+func (r *repo) Exists(ctx context.Context, first string, second string) (bool, error) {
+	builderSelect := sq.Select("1").
+		Prefix("SELECT EXISTS (").
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Or{
+			sq.Eq{blockerColumn: first, blockedColumn: second},
+			sq.Eq{blockerColumn: second, blockedColumn: first},
+		}).
+		Suffix(")")
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "blocks_repository.Exists",
+		QueryRaw: query,
+	}
+
+	var exists bool
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *repo) DeleteUser(ctx context.Context, username string) error {
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Or{sq.Eq{blockerColumn: username}, sq.Eq{blockedColumn: username}})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "blocks_repository.DeleteUser",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
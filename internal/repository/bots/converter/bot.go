@@ -0,0 +1,22 @@
+package converter
+
+import (
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository/bots/dao"
+)
+
+// ToBotFromRepo converts repository layer model to structure of service layer.
+func ToBotFromRepo(bot *dao.Bot) *model.Bot {
+	res := &model.Bot{
+		ID:           bot.ID,
+		Name:         bot.Name,
+		CreatedBy:    bot.CreatedBy,
+		CreatedAt:    bot.CreatedAt,
+		KeyRotatedAt: bot.KeyRotatedAt,
+	}
+	if bot.RevokedAt.Valid {
+		res.RevokedAt = &bot.RevokedAt.Time
+	}
+
+	return res
+}
@@ -0,0 +1,16 @@
+package dao
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Bot type is the main structure for bot account.
+type Bot struct {
+	ID           string       `db:"id"`
+	Name         string       `db:"name"`
+	CreatedBy    string       `db:"created_by"`
+	CreatedAt    time.Time    `db:"created_at"`
+	KeyRotatedAt time.Time    `db:"key_rotated_at"`
+	RevokedAt    sql.NullTime `db:"revoked_at"`
+}
@@ -0,0 +1,164 @@
+package bots
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"github.com/8thgencore/microservice-chat/internal/repository/bots/converter"
+	"github.com/8thgencore/microservice-chat/internal/repository/bots/dao"
+	"github.com/8thgencore/microservice-common/pkg/db"
+)
+
+const (
+	tableName = "bots"
+
+	idColumn           = "id"
+	nameColumn         = "name"
+	keyHashColumn      = "key_hash"
+	createdByColumn    = "created_by"
+	createdAtColumn    = "created_at"
+	keyRotatedAtColumn = "key_rotated_at"
+	revokedAtColumn    = "revoked_at"
+)
+
+var selectColumns = []string{
+	idColumn, nameColumn, createdByColumn, createdAtColumn, keyRotatedAtColumn, revokedAtColumn,
+}
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new object of repository layer.
+func NewRepository(db db.Client) repository.BotsRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Create(ctx context.Context, bot *model.Bot, keyHash string) (*model.Bot, error) {
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(nameColumn, keyHashColumn, createdByColumn).
+		Values(bot.Name, keyHash, bot.CreatedBy).
+		Suffix("ON CONFLICT (" + nameColumn + ") DO NOTHING RETURNING " + strings.Join(selectColumns, ", "))
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "bots_repository.Create",
+		QueryRaw: query,
+	}
+
+	var created dao.Bot
+	err = r.db.DB().ScanOneContext(ctx, &created, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrAlreadyExists
+		}
+		return nil, err
+	}
+
+	return converter.ToBotFromRepo(&created), nil
+}
+
+func (r *repo) GetByKeyHash(ctx context.Context, keyHash string) (*model.Bot, error) {
+	builderSelect := sq.Select(selectColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{keyHashColumn: keyHash, revokedAtColumn: nil})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "bots_repository.GetByKeyHash",
+		QueryRaw: query,
+	}
+
+	var bot dao.Bot
+	err = r.db.DB().ScanOneContext(ctx, &bot, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return converter.ToBotFromRepo(&bot), nil
+}
+
+func (r *repo) SetKeyHash(ctx context.Context, id string, keyHash string) error {
+	i, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(keyHashColumn, keyHash).
+		Set(keyRotatedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{idColumn: i, revokedAtColumn: nil})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "bots_repository.SetKeyHash",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *repo) Revoke(ctx context.Context, id string) error {
+	i, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(revokedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{idColumn: i, revokedAtColumn: nil})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "bots_repository.Revoke",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
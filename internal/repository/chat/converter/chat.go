@@ -1 +1,48 @@
 package converter
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository/chat/dao"
+)
+
+// ToChatFromRepo converts repository layer model to structure of service layer.
+func ToChatFromRepo(chat *dao.Chat) *model.Chat {
+	return &model.Chat{
+		ID:         chat.ID,
+		Usernames:  chat.Usernames,
+		MessageTTL: time.Duration(chat.MessageTTLSeconds.Int64) * time.Second,
+		Retention:  ToRetentionFromRepo(chat.RetentionSeconds),
+		E2E:        chat.E2E,
+	}
+}
+
+// ToRetentionFromRepo converts chat retention of repository layer to service layer value.
+// NULL means the default retention, zero keeps messages forever.
+func ToRetentionFromRepo(seconds sql.NullInt64) time.Duration {
+	if seconds.Valid && seconds.Int64 == 0 {
+		return model.RetentionForever
+	}
+
+	return time.Duration(seconds.Int64) * time.Second
+}
+
+// ToRetentionFromService converts chat retention to repository layer value.
+func ToRetentionFromService(d time.Duration) sql.NullInt64 {
+	if d == model.RetentionForever {
+		return sql.NullInt64{Int64: 0, Valid: true}
+	}
+
+	return ToSecondsFromService(d)
+}
+
+// ToSecondsFromService converts chat message lifetime to repository layer value.
+// Zero duration is stored as NULL.
+func ToSecondsFromService(d time.Duration) sql.NullInt64 {
+	return sql.NullInt64{
+		Int64: int64(d / time.Second),
+		Valid: d > 0,
+	}
+}
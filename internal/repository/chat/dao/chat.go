@@ -1,7 +1,14 @@
 package dao
 
+import (
+	"database/sql"
+)
+
 // Chat type is the main structure for chat.
 type Chat struct {
-	ID        string    `db:"id"`
-	Usernames []string  `db:"usernames"`
+	ID                string        `db:"id"`
+	Usernames         []string      `db:"usernames"`
+	MessageTTLSeconds sql.NullInt64 `db:"message_ttl_seconds"`
+	RetentionSeconds  sql.NullInt64 `db:"retention_seconds"`
+	E2E               bool          `db:"e2e"`
 }
@@ -2,20 +2,28 @@ package chat
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/8thgencore/microservice-chat/internal/model"
 	"github.com/8thgencore/microservice-chat/internal/repository"
+	"github.com/8thgencore/microservice-chat/internal/repository/chat/converter"
+	"github.com/8thgencore/microservice-chat/internal/repository/chat/dao"
 	"github.com/8thgencore/microservice-common/pkg/db"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 const (
 	tableName = "chats"
 
-	idColumn        = "id"
-	usernamesColumn = "usernames"
+	idColumn         = "id"
+	usernamesColumn  = "usernames"
+	messageTTLColumn = "message_ttl_seconds"
+	retentionColumn  = "retention_seconds"
+	e2eColumn        = "e2e"
 )
 
 type repo struct {
@@ -30,8 +38,13 @@ func NewRepository(db db.Client) repository.ChatRepository {
 func (r *repo) Create(ctx context.Context, chat *model.Chat) (string, error) {
 	builderInsert := sq.Insert(tableName).
 		PlaceholderFormat(sq.Dollar).
-		Columns(usernamesColumn).
-		Values(chat.Usernames).
+		Columns(usernamesColumn, messageTTLColumn, retentionColumn, e2eColumn).
+		Values(
+			chat.Usernames,
+			converter.ToSecondsFromService(chat.MessageTTL),
+			converter.ToRetentionFromService(chat.Retention),
+			chat.E2E,
+		).
 		Suffix(fmt.Sprintf("RETURNING %s", idColumn))
 
 	query, args, err := builderInsert.ToSql()
@@ -104,3 +117,124 @@ func (r *repo) GetChats(ctx context.Context) ([]string, error) {
 
 	return uuids.Strings(), nil
 }
+
+func (r *repo) Get(ctx context.Context, id string) (*model.Chat, error) {
+	i, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(idColumn, usernamesColumn, messageTTLColumn, retentionColumn, e2eColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: i})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.Get",
+		QueryRaw: query,
+	}
+
+	var chat dao.Chat
+	err = r.db.DB().ScanOneContext(ctx, &chat, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return converter.ToChatFromRepo(&chat), nil
+}
+
+func (r *repo) SetMessageTTL(ctx context.Context, id string, ttl time.Duration) error {
+	i, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(messageTTLColumn, converter.ToSecondsFromService(ttl)).
+		Where(sq.Eq{idColumn: i})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.SetMessageTTL",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *repo) SetRetention(ctx context.Context, id string, retention time.Duration) error {
+	i, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(retentionColumn, converter.ToRetentionFromService(retention)).
+		Where(sq.Eq{idColumn: i})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.SetRetention",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *repo) RemoveUser(ctx context.Context, username string) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(usernamesColumn, sq.Expr("array_remove("+usernamesColumn+", ?)", username)).
+		Where(sq.Expr("? = ANY("+usernamesColumn+")", username))
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.RemoveUser",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package converter
+
+import (
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository/devices/dao"
+)
+
+// ToDeviceKeyFromRepo converts repository layer model to structure of service layer.
+func ToDeviceKeyFromRepo(key *dao.DeviceKey) *model.DeviceKey {
+	return &model.DeviceKey{
+		ID:        key.ID,
+		Username:  key.Username,
+		DeviceID:  key.DeviceID,
+		PublicKey: key.PublicKey,
+		CreatedAt: key.CreatedAt,
+	}
+}
+
+// ToDeviceKeysFromRepo converts repository layer models to structures of service layer.
+func ToDeviceKeysFromRepo(keys []*dao.DeviceKey) []*model.DeviceKey {
+	res := make([]*model.DeviceKey, 0, len(keys))
+	for _, k := range keys {
+		res = append(res, ToDeviceKeyFromRepo(k))
+	}
+
+	return res
+}
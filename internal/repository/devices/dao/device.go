@@ -0,0 +1,12 @@
+package dao
+
+import "time"
+
+// DeviceKey type is the main structure for device public key.
+type DeviceKey struct {
+	ID        string    `db:"id"`
+	Username  string    `db:"username"`
+	DeviceID  string    `db:"device_id"`
+	PublicKey []byte    `db:"public_key"`
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -0,0 +1,192 @@
+package devices
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"github.com/8thgencore/microservice-chat/internal/repository/devices/converter"
+	"github.com/8thgencore/microservice-chat/internal/repository/devices/dao"
+	"github.com/8thgencore/microservice-common/pkg/db"
+)
+
+const (
+	tableName = "device_keys"
+
+	idColumn        = "id"
+	usernameColumn  = "username"
+	deviceIDColumn  = "device_id"
+	publicKeyColumn = "public_key"
+	createdAtColumn = "created_at"
+	revokedAtColumn = "revoked_at"
+)
+
+var selectColumns = []string{idColumn, usernameColumn, deviceIDColumn, publicKeyColumn, createdAtColumn}
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new object of repository layer.
+func NewRepository(db db.Client) repository.DevicesRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Create(ctx context.Context, key *model.DeviceKey) (*model.DeviceKey, error) {
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(usernameColumn, deviceIDColumn, publicKeyColumn).
+		Values(key.Username, key.DeviceID, key.PublicKey).
+		Suffix("ON CONFLICT (" + usernameColumn + ", " + deviceIDColumn + ") WHERE " + revokedAtColumn +
+			" IS NULL DO NOTHING RETURNING " + strings.Join(selectColumns, ", "))
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "devices_repository.Create",
+		QueryRaw: query,
+	}
+
+	var created dao.DeviceKey
+	err = r.db.DB().ScanOneContext(ctx, &created, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrAlreadyExists
+		}
+		return nil, err
+	}
+
+	return converter.ToDeviceKeyFromRepo(&created), nil
+}
+
+func (r *repo) Get(ctx context.Context, id string) (*model.DeviceKey, error) {
+	i, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(selectColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: i, revokedAtColumn: nil})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "devices_repository.Get",
+		QueryRaw: query,
+	}
+
+	var key dao.DeviceKey
+	err = r.db.DB().ScanOneContext(ctx, &key, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return converter.ToDeviceKeyFromRepo(&key), nil
+}
+
+func (r *repo) List(ctx context.Context, username string) ([]*model.DeviceKey, error) {
+	builderSelect := sq.Select(selectColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{usernameColumn: username, revokedAtColumn: nil}).
+		OrderBy(createdAtColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "devices_repository.List",
+		QueryRaw: query,
+	}
+
+	var keys []*dao.DeviceKey
+	err = r.db.DB().ScanAllContext(ctx, &keys, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToDeviceKeysFromRepo(keys), nil
+}
+
+func (r *repo) Revoke(ctx context.Context, id string) error {
+	i, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	return r.revoke(ctx, "devices_repository.Revoke", sq.Eq{idColumn: i, revokedAtColumn: nil})
+}
+
+func (r *repo) RevokeDevice(ctx context.Context, username string, deviceID string) error {
+	return r.revoke(ctx, "devices_repository.RevokeDevice",
+		sq.Eq{usernameColumn: username, deviceIDColumn: deviceID, revokedAtColumn: nil})
+}
+
+func (r *repo) revoke(ctx context.Context, name string, where sq.Eq) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(revokedAtColumn, sq.Expr("now()")).
+		Where(where)
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     name,
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *repo) DeleteUser(ctx context.Context, username string) error {
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{usernameColumn: username})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "devices_repository.DeleteUser",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package flags
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"github.com/8thgencore/microservice-common/pkg/db"
+)
+
+const (
+	tableName = "message_flags"
+
+	messageIDColumn = "message_id"
+	chatIDColumn    = "chat_id"
+	reasonColumn    = "reason"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new object of repository layer.
+func NewRepository(db db.Client) repository.FlagsRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Create(ctx context.Context, flags []*model.MessageFlag) error {
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(messageIDColumn, chatIDColumn, reasonColumn)
+	for _, f := range flags {
+		messageID, err := uuid.Parse(f.MessageID)
+		if err != nil {
+			return err
+		}
+		chatID, err := uuid.Parse(f.ChatID)
+		if err != nil {
+			return err
+		}
+		builderInsert = builderInsert.Values(messageID, chatID, f.Reason)
+	}
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "flags_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
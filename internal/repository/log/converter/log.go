@@ -0,0 +1,33 @@
+package converter
+
+import (
+	"encoding/json"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository/log/dao"
+)
+
+// ToLogsFromRepo converts repository layer models to structures of service layer.
+func ToLogsFromRepo(logs []*dao.Log) ([]*model.Log, error) {
+	res := make([]*model.Log, 0, len(logs))
+	for _, l := range logs {
+		var payload map[string]any
+		if err := json.Unmarshal(l.Payload, &payload); err != nil {
+			return nil, err
+		}
+
+		res = append(res, &model.Log{
+			ID:        l.ID,
+			Timestamp: l.Timestamp,
+			Actor:     l.Actor,
+			Action:    l.Action,
+			ChatID:    l.ChatID.String,
+			MessageID: l.MessageID.String,
+			RequestID: l.RequestID,
+			Payload:   payload,
+			Text:      l.Text,
+		})
+	}
+
+	return res, nil
+}
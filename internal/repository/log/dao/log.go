@@ -0,0 +1,19 @@
+package dao
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Log type is the main structure for transaction log record.
+type Log struct {
+	ID        string         `db:"id"`
+	Timestamp time.Time      `db:"timestamp"`
+	Actor     string         `db:"actor"`
+	Action    string         `db:"action"`
+	ChatID    sql.NullString `db:"chat_id"`
+	MessageID sql.NullString `db:"message_id"`
+	RequestID string         `db:"request_id"`
+	Payload   []byte         `db:"payload"`
+	Text      string         `db:"log"`
+}
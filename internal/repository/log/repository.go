@@ -2,10 +2,15 @@ package log
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
+	"github.com/8thgencore/microservice-chat/internal/identity"
 	"github.com/8thgencore/microservice-chat/internal/model"
 	"github.com/8thgencore/microservice-chat/internal/repository"
+	"github.com/8thgencore/microservice-chat/internal/repository/log/converter"
+	"github.com/8thgencore/microservice-chat/internal/repository/log/dao"
+	"github.com/8thgencore/microservice-chat/internal/requestid"
 	"github.com/8thgencore/microservice-common/pkg/db"
 	sq "github.com/Masterminds/squirrel"
 )
@@ -16,6 +21,12 @@ const (
 	idColumn        = "id"
 	timestampColumn = "timestamp"
 	logColumn       = "log"
+	actorColumn     = "actor"
+	actionColumn    = "action"
+	chatIDColumn    = "chat_id"
+	messageIDColumn = "message_id"
+	requestIDColumn = "request_id"
+	payloadColumn   = "payload"
 )
 
 type repo struct {
@@ -27,11 +38,32 @@ func NewRepository(db db.Client) repository.LogRepository {
 	return &repo{db: db}
 }
 
-func (r *repo) Log(ctx context.Context, text *model.Log) error {
+func (r *repo) Log(ctx context.Context, record *model.Log) error {
+	actor := record.Actor
+	if actor == "" {
+		actor = model.SystemActor
+		if principal, ok := identity.FromContext(ctx); ok && principal.Username != "" {
+			actor = principal.Username
+		}
+	}
+	requestID := record.RequestID
+	if requestID == "" {
+		requestID = requestid.FromContext(ctx)
+	}
+
+	payload := []byte("{}")
+	if len(record.Payload) > 0 {
+		var err error
+		payload, err = json.Marshal(record.Payload)
+		if err != nil {
+			return err
+		}
+	}
+
 	builderInsert := sq.Insert(tableName).
 		PlaceholderFormat(sq.Dollar).
-		Columns(logColumn).
-		Values(text.Text).
+		Columns(logColumn, actorColumn, actionColumn, chatIDColumn, messageIDColumn, requestIDColumn, payloadColumn).
+		Values(record.Text, actor, record.Action, nullUUID(record.ChatID), nullUUID(record.MessageID), requestID, payload).
 		Suffix(fmt.Sprintf("RETURNING %s", idColumn))
 
 	query, args, err := builderInsert.ToSql()
@@ -52,3 +84,143 @@ func (r *repo) Log(ctx context.Context, text *model.Log) error {
 
 	return nil
 }
+
+func (r *repo) Query(ctx context.Context, filter model.LogFilter) ([]*model.Log, error) {
+	builderSelect := sq.Select(
+		idColumn, timestampColumn, actorColumn, actionColumn, chatIDColumn,
+		messageIDColumn, requestIDColumn, payloadColumn, logColumn,
+	).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		OrderBy(timestampColumn+" DESC", idColumn+" DESC").
+		Limit(filter.Limit)
+
+	if filter.Actor != "" {
+		builderSelect = builderSelect.Where(sq.Eq{actorColumn: filter.Actor})
+	}
+	if filter.Action != "" {
+		builderSelect = builderSelect.Where(sq.Eq{actionColumn: filter.Action})
+	}
+	if filter.ChatID != "" {
+		builderSelect = builderSelect.Where(sq.Eq{chatIDColumn: filter.ChatID})
+	}
+	if !filter.From.IsZero() {
+		builderSelect = builderSelect.Where(sq.GtOrEq{timestampColumn: filter.From})
+	}
+	if !filter.To.IsZero() {
+		builderSelect = builderSelect.Where(sq.Lt{timestampColumn: filter.To})
+	}
+	if filter.Before != nil {
+		builderSelect = builderSelect.Where(
+			sq.Expr(fmt.Sprintf("(%s, %s) < (?, ?)", timestampColumn, idColumn), filter.Before.Timestamp, filter.Before.ID),
+		)
+	}
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "log_repository.Query",
+		QueryRaw: query,
+	}
+
+	var logs []*dao.Log
+	err = r.db.DB().ScanAllContext(ctx, &logs, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToLogsFromRepo(logs)
+}
+
+func (r *repo) AnonymizeUser(ctx context.Context, username string, pseudonym string) error {
+	// Candidates are narrowed down in the database, the text is matched on name boundaries by the model
+	builderSelect := sq.Select(
+		idColumn, timestampColumn, actorColumn, actionColumn, chatIDColumn,
+		messageIDColumn, requestIDColumn, payloadColumn, logColumn,
+	).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Or{
+			sq.Eq{actorColumn: username},
+			sq.Expr(fmt.Sprintf("strpos(%s, ?) > 0", logColumn), username),
+			sq.Expr(
+				fmt.Sprintf("jsonb_path_exists(%s, '$.** ?? (@ == $name)', jsonb_build_object('name', ?::text))", payloadColumn),
+				username,
+			),
+		})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "log_repository.AnonymizeUser",
+		QueryRaw: query,
+	}
+
+	var logs []*dao.Log
+	err = r.db.DB().ScanAllContext(ctx, &logs, q, args...)
+	if err != nil {
+		return err
+	}
+
+	records, err := converter.ToLogsFromRepo(logs)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		if !record.Anonymize(username, pseudonym) {
+			continue
+		}
+		if err = r.update(ctx, record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// update replaces the actor, payload and text of the record.
+func (r *repo) update(ctx context.Context, record *model.Log) error {
+	payload, err := json.Marshal(record.Payload)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(actorColumn, record.Actor).
+		Set(payloadColumn, payload).
+		Set(logColumn, record.Text).
+		Where(sq.Eq{idColumn: record.ID})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "log_repository.update",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// nullUUID stores empty IDs as NULL.
+func nullUUID(id string) interface{} {
+	if id == "" {
+		return nil
+	}
+	return id
+}
@@ -0,0 +1,21 @@
+package converter
+
+import (
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository/members/dao"
+)
+
+// ToMembersFromRepo converts repository layer models to structures of service layer.
+func ToMembersFromRepo(members []*dao.Member) []*model.Member {
+	res := make([]*model.Member, 0, len(members))
+	for _, m := range members {
+		res = append(res, &model.Member{
+			ChatID:   m.ChatID,
+			Username: m.Username,
+			Role:     model.ChatRole(m.Role),
+			JoinedAt: m.CreatedAt,
+		})
+	}
+
+	return res
+}
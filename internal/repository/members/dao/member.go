@@ -0,0 +1,12 @@
+package dao
+
+import "time"
+
+// Member type is the main structure for chat member.
+type Member struct {
+	ChatID   string `db:"chat_id"`
+	Username string `db:"username"`
+	Role     string `db:"role"`
+	// CreatedAt is only selected by queries which need it.
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -0,0 +1,178 @@
+package members
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"github.com/8thgencore/microservice-chat/internal/repository/members/converter"
+	"github.com/8thgencore/microservice-chat/internal/repository/members/dao"
+	"github.com/8thgencore/microservice-common/pkg/db"
+)
+
+const (
+	tableName = "chat_members"
+
+	chatIDColumn    = "chat_id"
+	usernameColumn  = "username"
+	roleColumn      = "role"
+	createdAtColumn = "created_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new object of repository layer.
+func NewRepository(db db.Client) repository.MembersRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Add(ctx context.Context, chatID string, members []*model.Member) error {
+	id, err := uuid.Parse(chatID)
+	if err != nil {
+		return err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatIDColumn, usernameColumn, roleColumn).
+		Suffix("ON CONFLICT DO NOTHING")
+	for _, m := range members {
+		builderInsert = builderInsert.Values(id, m.Username, m.Role)
+	}
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "members_repository.Add",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repo) List(ctx context.Context, chatID string) ([]*model.Member, error) {
+	id, err := uuid.Parse(chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(chatIDColumn, usernameColumn, roleColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: id}).
+		OrderBy(createdAtColumn, usernameColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "members_repository.List",
+		QueryRaw: query,
+	}
+
+	var members []*dao.Member
+	err = r.db.DB().ScanAllContext(ctx, &members, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToMembersFromRepo(members), nil
+}
+
+func (r *repo) SetRole(ctx context.Context, chatID string, username string, role model.ChatRole) error {
+	id, err := uuid.Parse(chatID)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(roleColumn, role).
+		Where(sq.Eq{chatIDColumn: id, usernameColumn: username})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "members_repository.SetRole",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *repo) ListByUser(ctx context.Context, username string) ([]*model.Member, error) {
+	builderSelect := sq.Select(chatIDColumn, usernameColumn, roleColumn, createdAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{usernameColumn: username}).
+		OrderBy(createdAtColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "members_repository.ListByUser",
+		QueryRaw: query,
+	}
+
+	var members []*dao.Member
+	err = r.db.DB().ScanAllContext(ctx, &members, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToMembersFromRepo(members), nil
+}
+
+func (r *repo) DeleteUser(ctx context.Context, username string) ([]string, error) {
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{usernameColumn: username}).
+		Suffix("RETURNING " + chatIDColumn)
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "members_repository.DeleteUser",
+		QueryRaw: query,
+	}
+
+	var chatIDs uuid.UUIDs
+	err = r.db.DB().ScanAllContext(ctx, &chatIDs, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return chatIDs.Strings(), nil
+}
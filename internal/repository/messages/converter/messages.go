@@ -1,6 +1,9 @@
 package converter
 
 import (
+	"database/sql"
+	"time"
+
 	"github.com/8thgencore/microservice-chat/internal/model"
 	"github.com/8thgencore/microservice-chat/internal/repository/messages/dao"
 )
@@ -10,11 +13,43 @@ func ToMessagesFromRepo(messages []*dao.Message) []*model.Message {
 	var res []*model.Message
 	for _, m := range messages {
 		res = append(res, &model.Message{
+			ID:        m.ID,
+			ChatID:    m.ChatID,
 			From:      m.From,
 			Text:      m.Text,
 			Timestamp: m.Timestamp,
+			ExpiresAt: m.ExpiresAt.Time,
+
+			ClientMessageID: m.ClientMessageID.String,
 		})
 	}
 
 	return res
 }
+
+// ToExpiresAtFromService converts message expiration time to repository layer value.
+func ToExpiresAtFromService(expiresAt time.Time) sql.NullTime {
+	return sql.NullTime{
+		Time:  expiresAt,
+		Valid: !expiresAt.IsZero(),
+	}
+}
+
+// ToChatCountsFromRepo converts repository layer model to number of messages per chat ID.
+func ToChatCountsFromRepo(counts []*dao.ChatCount) map[string]int {
+	res := make(map[string]int, len(counts))
+	for _, c := range counts {
+		res[c.ChatID] = c.Count
+	}
+
+	return res
+}
+
+// ToClientMessageIDFromService converts client message ID to repository layer value.
+// Empty ID is stored as NULL, so such messages are never deduplicated.
+func ToClientMessageIDFromService(clientMessageID string) sql.NullString {
+	return sql.NullString{
+		String: clientMessageID,
+		Valid:  clientMessageID != "",
+	}
+}
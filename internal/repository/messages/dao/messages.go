@@ -1,12 +1,35 @@
 package dao
 
 import (
+	"database/sql"
 	"time"
 )
 
 // Message type is the main structure for user message.
 type Message struct {
-	From      string    `db:"from_user"`
-	Text      string    `db:"text"`
-	Timestamp time.Time `db:"timestamp"`
+	ID        string       `db:"id"`
+	ChatID    string       `db:"chat_id"`
+	From      string       `db:"from_user"`
+	Text      string       `db:"text"`
+	Timestamp time.Time    `db:"timestamp"`
+	ExpiresAt sql.NullTime `db:"expires_at"`
+
+	ClientMessageID sql.NullString `db:"client_message_id"`
+	// KeyVersion is the data key version encrypting the text, NULL for plaintext.
+	KeyVersion sql.NullInt32 `db:"key_version"`
+}
+
+// ChatCount type is the number of messages in the chat.
+type ChatCount struct {
+	ChatID string `db:"chat_id"`
+	Count  int    `db:"count"`
+}
+
+// ChatKey type is a wrapped data key encrypting messages of the chat.
+type ChatKey struct {
+	ChatID      string    `db:"chat_id"`
+	Version     int       `db:"version"`
+	WrappedKey  []byte    `db:"wrapped_key"`
+	MasterKeyID string    `db:"master_key_id"`
+	CreatedAt   time.Time `db:"created_at"`
 }
@@ -0,0 +1,298 @@
+package messages
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/8thgencore/microservice-chat/internal/repository/messages/dao"
+	"github.com/8thgencore/microservice-common/pkg/db"
+	"github.com/8thgencore/microservice-common/pkg/db/pg"
+)
+
+const (
+	keysTableName = "chat_keys"
+
+	keysChatIDColumn      = "chat_id"
+	keysVersionColumn     = "version"
+	keysWrappedKeyColumn  = "wrapped_key"
+	keysMasterKeyIDColumn = "master_key_id"
+	keysCreatedAtColumn   = "created_at"
+
+	// currentKeyTTL bounds how long a replica keeps writing with a data key rotated by another replica.
+	currentKeyTTL = time.Minute
+)
+
+var errNoKeyring = errors.New("message is encrypted, but no master key file is configured")
+
+// dataKey is an unwrapped data key of a chat.
+type dataKey struct {
+	version   int
+	aead      cipher.AEAD
+	expiresAt time.Time
+}
+
+type keyRef struct {
+	chatID  string
+	version int
+}
+
+// keyCache keeps unwrapped data keys, so the master key is not used on every message.
+type keyCache struct {
+	mu       sync.RWMutex
+	current  map[string]*dataKey
+	versions map[keyRef]*dataKey
+}
+
+func newKeyCache() *keyCache {
+	return &keyCache{
+		current:  make(map[string]*dataKey),
+		versions: make(map[keyRef]*dataKey),
+	}
+}
+
+// TextCipher encrypts message texts with per-chat data keys wrapped by the keyring.
+// It is shared by repositories storing texts of chat messages, so they use the same keys.
+type TextCipher struct {
+	db db.Client
+
+	keyring *Keyring
+	keys    *keyCache
+}
+
+// NewTextCipher creates new TextCipher, nil keyring stores texts as is.
+func NewTextCipher(db db.Client, keyring *Keyring) *TextCipher {
+	return &TextCipher{
+		db:      db,
+		keyring: keyring,
+		keys:    newKeyCache(),
+	}
+}
+
+// Encrypt seals the text with the current data key of the chat.
+// The text is stored as is with NULL key version if encryption is disabled.
+func (c *TextCipher) Encrypt(ctx context.Context, chatID string, text string) (string, sql.NullInt32, error) {
+	if c.keyring == nil {
+		return text, sql.NullInt32{}, nil
+	}
+
+	key, err := c.currentKey(ctx, chatID)
+	if err != nil {
+		return "", sql.NullInt32{}, err
+	}
+
+	sealed, err := seal(key.aead, []byte(text), []byte(chatID))
+	if err != nil {
+		return "", sql.NullInt32{}, err
+	}
+
+	return base64.StdEncoding.EncodeToString(sealed), sql.NullInt32{Int32: int32(key.version), Valid: true}, nil
+}
+
+// decrypt replaces encrypted texts of the messages with plaintext, unencrypted messages are left as is.
+func (r *repo) decrypt(ctx context.Context, messages []*dao.Message) error {
+	for _, m := range messages {
+		text, err := r.cipher.Decrypt(ctx, m.ChatID, m.Text, m.KeyVersion)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt message %s: %w", m.ID, err)
+		}
+		m.Text = text
+	}
+
+	return nil
+}
+
+// Decrypt opens the text sealed with the data key version, texts without version are returned as is.
+func (c *TextCipher) Decrypt(ctx context.Context, chatID string, text string, version sql.NullInt32) (string, error) {
+	if !version.Valid {
+		return text, nil
+	}
+	if c.keyring == nil {
+		return "", errNoKeyring
+	}
+
+	key, err := c.keyVersion(ctx, chatID, int(version.Int32))
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(text)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := open(key.aead, sealed, []byte(chatID))
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
+
+// currentKey returns the latest data key of the chat and creates the first one for a new chat.
+func (c *TextCipher) currentKey(ctx context.Context, chatID string) (*dataKey, error) {
+	c.keys.mu.RLock()
+	key, ok := c.keys.current[chatID]
+	c.keys.mu.RUnlock()
+
+	if ok && time.Now().Before(key.expiresAt) {
+		return key, nil
+	}
+
+	// Keys are only read and cached once committed, messages of a rolled back transaction
+	// must not leave a cached key behind which the database does not know
+	ctx = withoutTx(ctx)
+
+	key, err := c.loadKey(ctx, chatID, 0)
+	if errors.Is(err, sql.ErrNoRows) {
+		// Concurrent creation of the first key by another call is resolved by the primary key
+		if err = c.createKey(ctx, chatID, 1); err != nil {
+			return nil, err
+		}
+		key, err = c.loadKey(ctx, chatID, 0)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key.expiresAt = time.Now().Add(currentKeyTTL)
+
+	c.keys.mu.Lock()
+	c.keys.current[chatID] = key
+	c.keys.versions[keyRef{chatID: chatID, version: key.version}] = key
+	c.keys.mu.Unlock()
+
+	return key, nil
+}
+
+// keyVersion returns the data key of the chat with the version, old versions are kept for old messages.
+func (c *TextCipher) keyVersion(ctx context.Context, chatID string, version int) (*dataKey, error) {
+	ref := keyRef{chatID: chatID, version: version}
+
+	c.keys.mu.RLock()
+	key, ok := c.keys.versions[ref]
+	c.keys.mu.RUnlock()
+
+	if ok {
+		return key, nil
+	}
+
+	key, err := c.loadKey(ctx, chatID, version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("data key version %d of chat %s does not exist", version, chatID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c.keys.mu.Lock()
+	c.keys.versions[ref] = key
+	c.keys.mu.Unlock()
+
+	return key, nil
+}
+
+// loadKey reads and unwraps the data key with the version, zero version loads the latest one.
+// It returns sql.ErrNoRows if there is no such key.
+func (c *TextCipher) loadKey(ctx context.Context, chatID string, version int) (*dataKey, error) {
+	id, err := uuid.Parse(chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(keysVersionColumn, keysWrappedKeyColumn, keysMasterKeyIDColumn).
+		From(keysTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{keysChatIDColumn: id}).
+		OrderBy(keysVersionColumn + " DESC").
+		Limit(1)
+	if version > 0 {
+		builderSelect = builderSelect.Where(sq.Eq{keysVersionColumn: version})
+	}
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "messages_repository.loadKey",
+		QueryRaw: query,
+	}
+
+	var keys []*dao.ChatKey
+	err = c.db.DB().ScanAllContext(ctx, &keys, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	raw, err := c.keyring.unwrap(chatID, keys[0].Version, keys[0].MasterKeyID, keys[0].WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dataKey{version: keys[0].Version, aead: aead}, nil
+}
+
+// createKey generates the data key with the version, an existing key with the version is kept.
+// The key is committed at once, whatever happens to the transaction of the caller.
+func (c *TextCipher) createKey(ctx context.Context, chatID string, version int) error {
+	ctx = withoutTx(ctx)
+
+	id, err := uuid.Parse(chatID)
+	if err != nil {
+		return err
+	}
+
+	raw := make([]byte, keySize)
+	if _, err = rand.Read(raw); err != nil {
+		return err
+	}
+
+	wrapped, masterKeyID, err := c.keyring.wrap(chatID, version, raw)
+	if err != nil {
+		return err
+	}
+
+	builderInsert := sq.Insert(keysTableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(keysChatIDColumn, keysVersionColumn, keysWrappedKeyColumn, keysMasterKeyIDColumn).
+		Values(id, version, wrapped, masterKeyID).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "messages_repository.createKey",
+		QueryRaw: query,
+	}
+
+	_, err = c.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
+
+// withoutTx returns the context whose queries run outside the transaction of the caller.
+func withoutTx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, pg.TxKey, nil)
+}
@@ -0,0 +1,138 @@
+package messages
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+)
+
+const (
+	// keySize is the size of master and data keys, they are AES-256 keys.
+	keySize = 32
+	// defaultMasterKeyID names the key of a file holding a single key without ID.
+	defaultMasterKeyID = "default"
+)
+
+// ErrKeyFileMissing is returned by LoadKeyring when the master key file does not exist.
+var ErrKeyFileMissing = errors.New("master key file does not exist")
+
+// Keyring holds master keys wrapping per-chat data keys.
+// The first key of the file wraps new data keys, the others only unwrap keys wrapped before rotation.
+type Keyring struct {
+	primary string
+	keys    map[string]cipher.AEAD
+}
+
+// LoadKeyring reads master keys from the file. Each non-empty line not starting with # is
+// "<id>:<base64 key>", or just "<base64 key>" in a file with a single key.
+func LoadKeyring(path string) (*Keyring, error) {
+	f, err := os.Open(path) // #nosec G304 -- the path comes from the service configuration
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrKeyFileMissing, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open master key file: %w", err)
+	}
+	defer f.Close()
+
+	k := &Keyring{keys: make(map[string]cipher.AEAD)}
+
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		id, encoded, ok := strings.Cut(line, ":")
+		if !ok {
+			id, encoded = defaultMasterKeyID, line
+		}
+		if _, exists := k.keys[id]; exists {
+			return nil, fmt.Errorf("master key file %s line %d: duplicate key id %q", path, n, id)
+		}
+
+		key, errDecode := base64.StdEncoding.DecodeString(encoded)
+		if errDecode != nil || len(key) != keySize {
+			return nil, fmt.Errorf("master key file %s line %d: key must be %d base64 encoded bytes", path, n, keySize)
+		}
+
+		aead, errAEAD := newAEAD(key)
+		if errAEAD != nil {
+			return nil, errAEAD
+		}
+
+		k.keys[id] = aead
+		if k.primary == "" {
+			k.primary = id
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read master key file: %w", err)
+	}
+	if k.primary == "" {
+		return nil, fmt.Errorf("master key file %s has no keys", path)
+	}
+
+	return k, nil
+}
+
+// wrap encrypts the data key with the primary master key and returns it with the master key ID.
+// The chat and key version are authenticated, so a wrapped key can not be moved to another chat.
+func (k *Keyring) wrap(chatID string, version int, dataKey []byte) ([]byte, string, error) {
+	sealed, err := seal(k.keys[k.primary], dataKey, dataKeyAD(chatID, version))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return sealed, k.primary, nil
+}
+
+func (k *Keyring) unwrap(chatID string, version int, masterKeyID string, wrapped []byte) ([]byte, error) {
+	aead, ok := k.keys[masterKeyID]
+	if !ok {
+		return nil, fmt.Errorf("master key %q of chat %s key version %d is not in the key file", masterKeyID, chatID, version)
+	}
+
+	return open(aead, wrapped, dataKeyAD(chatID, version))
+}
+
+func dataKeyAD(chatID string, version int) []byte {
+	return []byte(fmt.Sprintf("%s:%d", chatID, version))
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts the plaintext with a random nonce put before the ciphertext.
+func seal(aead cipher.AEAD, plaintext []byte, ad []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, ad), nil
+}
+
+func open(aead cipher.AEAD, sealed []byte, ad []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+
+	return aead.Open(nil, nonce, ciphertext, ad)
+}
@@ -0,0 +1,168 @@
+package messages
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeKeyFile(t *testing.T, lines ...string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "keys")
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func testKey(b byte) string {
+	return base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{b}, keySize))
+}
+
+func TestLoadKeyring(t *testing.T) {
+	tests := []struct {
+		name    string
+		lines   []string
+		primary string
+		wantErr bool
+	}{
+		{name: "single key without id", lines: []string{testKey(1)}, primary: defaultMasterKeyID},
+		{
+			name:    "first key is primary",
+			lines:   []string{"# rotated", "new:" + testKey(2), "", "old:" + testKey(1)},
+			primary: "new",
+		},
+		{name: "duplicate id", lines: []string{"a:" + testKey(1), "a:" + testKey(2)}, wantErr: true},
+		{name: "short key", lines: []string{"a:" + base64.StdEncoding.EncodeToString([]byte("short"))}, wantErr: true},
+		{name: "no keys", lines: []string{"# empty"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := LoadKeyring(writeKeyFile(t, tt.lines...))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if k.primary != tt.primary {
+				t.Errorf("primary = %q, want %q", k.primary, tt.primary)
+			}
+		})
+	}
+}
+
+func TestLoadKeyringMissing(t *testing.T) {
+	_, err := LoadKeyring(filepath.Join(t.TempDir(), "missing"))
+	if !errors.Is(err, ErrKeyFileMissing) {
+		t.Fatalf("err = %v, want ErrKeyFileMissing", err)
+	}
+}
+
+func TestKeyringRotation(t *testing.T) {
+	old, err := LoadKeyring(writeKeyFile(t, "old:"+testKey(1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotated, err := LoadKeyring(writeKeyFile(t, "new:"+testKey(2), "old:"+testKey(1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dataKey := bytes.Repeat([]byte{9}, keySize)
+
+	wrapped, masterKeyID, err := old.wrap("chat", 1, dataKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		keyring     *Keyring
+		chatID      string
+		version     int
+		masterKeyID string
+		wantErr     bool
+	}{
+		{name: "same keyring", keyring: old, chatID: "chat", version: 1, masterKeyID: masterKeyID},
+		{name: "retired key after rotation", keyring: rotated, chatID: "chat", version: 1, masterKeyID: masterKeyID},
+		{name: "another chat", keyring: old, chatID: "other", version: 1, masterKeyID: masterKeyID, wantErr: true},
+		{name: "another version", keyring: old, chatID: "chat", version: 2, masterKeyID: masterKeyID, wantErr: true},
+		{name: "unknown master key", keyring: old, chatID: "chat", version: 1, masterKeyID: "new", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := tt.keyring.unwrap(tt.chatID, tt.version, tt.masterKeyID, wrapped)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(raw, dataKey) {
+				t.Error("unwrapped key differs from the data key")
+			}
+		})
+	}
+
+	// Rewrapping moves the data key to the primary master key, so the retired one can be dropped.
+	rewrapped, masterKeyID, err := rotated.wrap("chat", 1, dataKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if masterKeyID != "new" {
+		t.Fatalf("master key = %q, want new", masterKeyID)
+	}
+	current, err := LoadKeyring(writeKeyFile(t, "new:"+testKey(2)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = current.unwrap("chat", 1, masterKeyID, rewrapped); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTextCipherPlaintext(t *testing.T) {
+	text, version, err := NewTextCipher(nil, nil).Encrypt(context.Background(), "chat", "hello")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text != "hello" || version.Valid {
+		t.Errorf("Encrypt = %q, %v, want plaintext without version when encryption is disabled", text, version)
+	}
+
+	tests := []struct {
+		name   string
+		cipher *TextCipher
+	}{
+		{name: "encryption disabled", cipher: NewTextCipher(nil, nil)},
+		{name: "legacy plaintext row", cipher: NewTextCipher(nil, &Keyring{})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, err := tt.cipher.Decrypt(context.Background(), "chat", "hello", sql.NullInt32{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if text != "hello" {
+				t.Errorf("Decrypt = %q, want hello", text)
+			}
+		})
+	}
+}
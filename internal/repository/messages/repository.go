@@ -2,9 +2,13 @@ package messages
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 
 	"github.com/8thgencore/microservice-chat/internal/model"
 	"github.com/8thgencore/microservice-chat/internal/repository"
@@ -16,35 +20,71 @@ import (
 const (
 	tableName = "messages"
 
-	chatIDColumn    = "chat_id"
-	fromColumn      = "from_user"
-	textColumn      = "text"
-	timestampColumn = "timestamp"
+	idColumn         = "id"
+	chatIDColumn     = "chat_id"
+	fromColumn       = "from_user"
+	textColumn       = "text"
+	timestampColumn  = "timestamp"
+	expiresAtColumn  = "expires_at"
+	clientIDColumn   = "client_message_id"
+	keyVersionColumn = "key_version"
+
+	chatsTableName       = "chats"
+	chatsIDColumn        = "id"
+	chatsRetentionColumn = "retention_seconds"
+	messagesAlias        = "m"
+	chatsAlias           = "c"
 )
 
+var selectColumns = []string{
+	idColumn, chatIDColumn, fromColumn, textColumn, timestampColumn, expiresAtColumn, clientIDColumn, keyVersionColumn,
+}
+
 type repo struct {
 	db db.Client
+
+	cipher *TextCipher
 }
 
 // NewRepository creates new object of repository layer.
-func NewRepository(db db.Client) repository.MessagesRepository {
-	return &repo{db: db}
+// Message texts are encrypted with the cipher.
+func NewRepository(db db.Client, cipher *TextCipher) repository.MessagesRepository {
+	return &repo{
+		db:     db,
+		cipher: cipher,
+	}
 }
 
-func (r *repo) Create(ctx context.Context, chatID string, message *model.Message) error {
+func (r *repo) Create(ctx context.Context, chatID string, message *model.Message) (string, error) {
 	id, err := uuid.Parse(chatID)
 	if err != nil {
-		return err
+		return "", err
 	}
 
+	text, keyVersion, err := r.cipher.Encrypt(ctx, chatID, message.Text)
+	if err != nil {
+		return "", err
+	}
+
+	// Conflicting insert waits for the concurrent one to finish and returns no rows.
 	builderInsert := sq.Insert(tableName).
 		PlaceholderFormat(sq.Dollar).
-		Columns(chatIDColumn, fromColumn, textColumn, timestampColumn).
-		Values(id, message.From, message.Text, message.Timestamp)
+		Columns(chatIDColumn, fromColumn, textColumn, timestampColumn, expiresAtColumn, clientIDColumn, keyVersionColumn).
+		Values(
+			id,
+			message.From,
+			text,
+			message.Timestamp,
+			converter.ToExpiresAtFromService(message.ExpiresAt),
+			converter.ToClientMessageIDFromService(message.ClientMessageID),
+			keyVersion,
+		).
+		Suffix(fmt.Sprintf("ON CONFLICT (%s, %s, %s) DO NOTHING RETURNING %s",
+			chatIDColumn, fromColumn, clientIDColumn, idColumn))
 
 	query, args, err := builderInsert.ToSql()
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	q := db.Query{
@@ -52,12 +92,102 @@ func (r *repo) Create(ctx context.Context, chatID string, message *model.Message
 		QueryRaw: query,
 	}
 
-	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	var messageID uuid.UUID
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&messageID)
 	if err != nil {
-		return err
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", repository.ErrAlreadyExists
+		}
+		return "", err
 	}
 
-	return nil
+	return messageID.String(), nil
+}
+
+func (r *repo) CreateBatch(ctx context.Context, chatID string, messages []*model.Message) (int, error) {
+	id, err := uuid.Parse(chatID)
+	if err != nil {
+		return 0, err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatIDColumn, fromColumn, textColumn, timestampColumn, clientIDColumn, keyVersionColumn).
+		Suffix(fmt.Sprintf("ON CONFLICT (%s, %s, %s) DO NOTHING", chatIDColumn, fromColumn, clientIDColumn))
+
+	for _, m := range messages {
+		text, keyVersion, errEncrypt := r.cipher.Encrypt(ctx, chatID, m.Text)
+		if errEncrypt != nil {
+			return 0, errEncrypt
+		}
+
+		builderInsert = builderInsert.Values(
+			id,
+			m.From,
+			text,
+			m.Timestamp,
+			converter.ToClientMessageIDFromService(m.ClientMessageID),
+			keyVersion,
+		)
+	}
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "messages_repository.CreateBatch",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(res.RowsAffected()), nil
+}
+
+func (r *repo) GetByClientMessageID(
+	ctx context.Context,
+	chatID string,
+	from string,
+	clientMessageID string,
+) (*model.Message, error) {
+	id, err := uuid.Parse(chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(selectColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: id, fromColumn: from, clientIDColumn: clientMessageID})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "messages_repository.GetByClientMessageID",
+		QueryRaw: query,
+	}
+
+	var messages []*dao.Message
+	err = r.db.DB().ScanAllContext(ctx, &messages, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.decrypt(ctx, messages); err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 {
+		return nil, repository.ErrNotFound
+	}
+
+	return converter.ToMessagesFromRepo(messages)[0], nil
 }
 
 func (r *repo) GetMessages(ctx context.Context, chatID string) ([]*model.Message, error) {
@@ -66,10 +196,13 @@ func (r *repo) GetMessages(ctx context.Context, chatID string) ([]*model.Message
 		return nil, err
 	}
 
-	builderSelect := sq.Select(fromColumn, textColumn, timestampColumn).
+	// Expired messages are filtered here, so they are never returned even before the reaper removes them.
+	builderSelect := sq.Select(selectColumns...).
 		From(tableName).
 		PlaceholderFormat(sq.Dollar).
-		Where(sq.Eq{chatIDColumn: id})
+		Where(sq.Eq{chatIDColumn: id}).
+		Where(notExpired()).
+		OrderBy(timestampColumn)
 
 	query, args, err := builderSelect.ToSql()
 	if err != nil {
@@ -86,10 +219,81 @@ func (r *repo) GetMessages(ctx context.Context, chatID string) ([]*model.Message
 	if err != nil {
 		return nil, err
 	}
+	if err = r.decrypt(ctx, messages); err != nil {
+		return nil, err
+	}
 
 	return converter.ToMessagesFromRepo(messages), nil
 }
 
+func (r *repo) Get(ctx context.Context, id string) (*model.Message, error) {
+	i, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(selectColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: i}).
+		Where(notExpired())
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "messages_repository.Get",
+		QueryRaw: query,
+	}
+
+	var messages []*dao.Message
+	err = r.db.DB().ScanAllContext(ctx, &messages, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.decrypt(ctx, messages); err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 {
+		return nil, repository.ErrNotFound
+	}
+
+	return converter.ToMessagesFromRepo(messages)[0], nil
+}
+
+func (r *repo) Delete(ctx context.Context, id string) error {
+	i, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: i})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "messages_repository.Delete",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
 func (r *repo) DeleteChat(ctx context.Context, chatID string) error {
 	id, err := uuid.Parse(chatID)
 	if err != nil {
@@ -117,3 +321,195 @@ func (r *repo) DeleteChat(ctx context.Context, chatID string) error {
 
 	return nil
 }
+
+func (r *repo) DeleteExpired(ctx context.Context, limit uint64) ([]*model.Message, error) {
+	expired := sq.Select(idColumn).
+		From(tableName).
+		Where(sq.Expr(expiresAtColumn + " <= now()")).
+		OrderBy(expiresAtColumn).
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Expr(idColumn+" IN (?)", expired)).
+		Suffix(fmt.Sprintf("RETURNING %s, %s", idColumn, chatIDColumn))
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "messages_repository.DeleteExpired",
+		QueryRaw: query,
+	}
+
+	var messages []*dao.Message
+	err = r.db.DB().ScanAllContext(ctx, &messages, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToMessagesFromRepo(messages), nil
+}
+
+func notExpired() sq.Sqlizer {
+	return sq.Or{
+		sq.Eq{expiresAtColumn: nil},
+		sq.Expr(expiresAtColumn + " > now()"),
+	}
+}
+
+func (r *repo) DeleteOutsideRetention(
+	ctx context.Context,
+	defaultRetention time.Duration,
+	limit uint64,
+) ([]*model.Message, error) {
+	outdated := sq.Select(messagesAlias + "." + idColumn).
+		From(tableName + " " + messagesAlias).
+		Join(joinChats()).
+		Where(outsideRetention(defaultRetention)).
+		OrderBy(messagesAlias + "." + timestampColumn).
+		Limit(limit).
+		Suffix("FOR UPDATE OF " + messagesAlias + " SKIP LOCKED")
+
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Expr(idColumn+" IN (?)", outdated)).
+		Suffix(fmt.Sprintf("RETURNING %s, %s", idColumn, chatIDColumn))
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "messages_repository.DeleteOutsideRetention",
+		QueryRaw: query,
+	}
+
+	var messages []*dao.Message
+	err = r.db.DB().ScanAllContext(ctx, &messages, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToMessagesFromRepo(messages), nil
+}
+
+func (r *repo) CountOutsideRetention(ctx context.Context, defaultRetention time.Duration) (map[string]int, error) {
+	builderSelect := sq.Select(messagesAlias+"."+chatIDColumn, "count(*) AS count").
+		From(tableName + " " + messagesAlias).
+		PlaceholderFormat(sq.Dollar).
+		Join(joinChats()).
+		Where(outsideRetention(defaultRetention)).
+		GroupBy(messagesAlias + "." + chatIDColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "messages_repository.CountOutsideRetention",
+		QueryRaw: query,
+	}
+
+	var counts []*dao.ChatCount
+	err = r.db.DB().ScanAllContext(ctx, &counts, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToChatCountsFromRepo(counts), nil
+}
+
+func joinChats() string {
+	return fmt.Sprintf("%s %s ON %s.%s = %s.%s",
+		chatsTableName, chatsAlias, chatsAlias, chatsIDColumn, messagesAlias, chatIDColumn)
+}
+
+// outsideRetention matches messages older than the retention of their chat.
+// Chats without own retention use the default one, zero retention keeps messages forever.
+func outsideRetention(defaultRetention time.Duration) sq.Sqlizer {
+	retention := fmt.Sprintf("COALESCE(%s.%s, ?)", chatsAlias, chatsRetentionColumn)
+	seconds := int64(defaultRetention / time.Second)
+
+	return sq.And{
+		sq.Expr(retention+" > 0", seconds),
+		sq.Expr(
+			fmt.Sprintf("%s.%s < now() - make_interval(secs => %s)", messagesAlias, timestampColumn, retention),
+			seconds,
+		),
+	}
+}
+
+func (r *repo) ListByAuthor(
+	ctx context.Context,
+	username string,
+	after *model.Message,
+	limit uint64,
+) ([]*model.Message, error) {
+	builderSelect := sq.Select(selectColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{fromColumn: username}).
+		Where(notExpired()).
+		OrderBy(timestampColumn, idColumn).
+		Limit(limit)
+	if after != nil {
+		id, err := uuid.Parse(after.ID)
+		if err != nil {
+			return nil, err
+		}
+		builderSelect = builderSelect.Where(
+			sq.Expr("("+timestampColumn+", "+idColumn+") > (?, ?)", after.Timestamp, id),
+		)
+	}
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "messages_repository.ListByAuthor",
+		QueryRaw: query,
+	}
+
+	var messages []*dao.Message
+	err = r.db.DB().ScanAllContext(ctx, &messages, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.decrypt(ctx, messages); err != nil {
+		return nil, err
+	}
+
+	return converter.ToMessagesFromRepo(messages), nil
+}
+
+func (r *repo) AnonymizeAuthor(ctx context.Context, username string, pseudonym string) (int, error) {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(fromColumn, pseudonym).
+		Where(sq.Eq{fromColumn: username})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "messages_repository.AnonymizeAuthor",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(res.RowsAffected()), nil
+}
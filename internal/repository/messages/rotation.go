@@ -0,0 +1,222 @@
+package messages
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"github.com/8thgencore/microservice-chat/internal/repository/messages/dao"
+	"github.com/8thgencore/microservice-common/pkg/db"
+)
+
+func (r *repo) RotateKeys(ctx context.Context, maxAge time.Duration) (int, error) {
+	if r.cipher.keyring == nil {
+		return 0, nil
+	}
+
+	if err := r.rewrapKeys(ctx); err != nil {
+		return 0, err
+	}
+	if maxAge <= 0 {
+		return 0, nil
+	}
+
+	builderSelect := sq.Select(keysChatIDColumn, fmt.Sprintf("max(%s) AS %s", keysVersionColumn, keysVersionColumn)).
+		From(keysTableName).
+		PlaceholderFormat(sq.Dollar).
+		GroupBy(keysChatIDColumn).
+		Having(fmt.Sprintf("max(%s) < ?", keysCreatedAtColumn), time.Now().Add(-maxAge))
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "messages_repository.RotateKeys",
+		QueryRaw: query,
+	}
+
+	var stale []*dao.ChatKey
+	err = r.db.DB().ScanAllContext(ctx, &stale, q, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, key := range stale {
+		if err = r.cipher.createKey(ctx, key.ChatID, key.Version+1); err != nil {
+			return 0, err
+		}
+
+		r.cipher.keys.mu.Lock()
+		delete(r.cipher.keys.current, key.ChatID)
+		r.cipher.keys.mu.Unlock()
+	}
+
+	return len(stale), nil
+}
+
+// rewrapKeys wraps data keys wrapped by retired master keys with the primary one.
+// Data keys themselves do not change, so messages need no re-encryption.
+func (r *repo) rewrapKeys(ctx context.Context) error {
+	builderSelect := sq.Select(keysChatIDColumn, keysVersionColumn, keysWrappedKeyColumn, keysMasterKeyIDColumn).
+		From(keysTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.NotEq{keysMasterKeyIDColumn: r.cipher.keyring.primary})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "messages_repository.rewrapKeys",
+		QueryRaw: query,
+	}
+
+	var keys []*dao.ChatKey
+	err = r.db.DB().ScanAllContext(ctx, &keys, q, args...)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		raw, errUnwrap := r.cipher.keyring.unwrap(key.ChatID, key.Version, key.MasterKeyID, key.WrappedKey)
+		if errUnwrap != nil {
+			return errUnwrap
+		}
+		wrapped, masterKeyID, errWrap := r.cipher.keyring.wrap(key.ChatID, key.Version, raw)
+		if errWrap != nil {
+			return errWrap
+		}
+
+		builderUpdate := sq.Update(keysTableName).
+			PlaceholderFormat(sq.Dollar).
+			Set(keysWrappedKeyColumn, wrapped).
+			Set(keysMasterKeyIDColumn, masterKeyID).
+			Where(sq.Eq{
+				keysChatIDColumn:      key.ChatID,
+				keysVersionColumn:     key.Version,
+				keysMasterKeyIDColumn: key.MasterKeyID,
+			})
+
+		query, args, err = builderUpdate.ToSql()
+		if err != nil {
+			return err
+		}
+
+		q = db.Query{
+			Name:     "messages_repository.rewrapKeys",
+			QueryRaw: query,
+		}
+
+		if _, err = r.db.DB().ExecContext(ctx, q, args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *repo) Reencrypt(ctx context.Context, after string, limit uint64) (*repository.ReencryptResult, error) {
+	if r.cipher.keyring == nil {
+		return &repository.ReencryptResult{}, nil
+	}
+
+	latest := sq.Select(fmt.Sprintf("max(k.%s)", keysVersionColumn)).
+		From(keysTableName + " k").
+		Where(fmt.Sprintf("k.%s = %s.%s", keysChatIDColumn, messagesAlias, chatIDColumn))
+	latestSQL, _, err := latest.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(
+		messagesAlias+"."+idColumn,
+		messagesAlias+"."+chatIDColumn,
+		messagesAlias+"."+textColumn,
+		messagesAlias+"."+keyVersionColumn,
+	).
+		From(tableName + " " + messagesAlias).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Or{
+			sq.Eq{messagesAlias + "." + keyVersionColumn: nil},
+			sq.Expr(fmt.Sprintf("%s.%s < (%s)", messagesAlias, keyVersionColumn, latestSQL)),
+		}).
+		OrderBy(messagesAlias + "." + idColumn).
+		Limit(limit)
+	if after != "" {
+		builderSelect = builderSelect.Where(sq.Gt{messagesAlias + "." + idColumn: after})
+	}
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "messages_repository.Reencrypt",
+		QueryRaw: query,
+	}
+
+	var messages []*dao.Message
+	err = r.db.DB().ScanAllContext(ctx, &messages, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keys rotated by other replicas must be picked up, otherwise rows would be rewritten with a stale key
+	r.cipher.keys.mu.Lock()
+	clear(r.cipher.keys.current)
+	r.cipher.keys.mu.Unlock()
+
+	res := &repository.ReencryptResult{Count: len(messages)}
+	for _, m := range messages {
+		res.Last = m.ID
+		if err = r.reencrypt(ctx, m); err != nil {
+			res.Failed = append(res.Failed, fmt.Errorf("failed to re-encrypt message %s: %w", m.ID, err))
+		}
+	}
+
+	return res, nil
+}
+
+// reencrypt rewrites the message with the latest key of its chat.
+// Rows changed meanwhile are left for the next run.
+func (r *repo) reencrypt(ctx context.Context, m *dao.Message) error {
+	plaintext, err := r.cipher.Decrypt(ctx, m.ChatID, m.Text, m.KeyVersion)
+	if err != nil {
+		return err
+	}
+	text, keyVersion, err := r.cipher.Encrypt(ctx, m.ChatID, plaintext)
+	if err != nil {
+		return err
+	}
+
+	var previous interface{}
+	if m.KeyVersion.Valid {
+		previous = m.KeyVersion.Int32
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(textColumn, text).
+		Set(keyVersionColumn, keyVersion).
+		Where(sq.Eq{idColumn: m.ID, keyVersionColumn: previous})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "messages_repository.Reencrypt",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
@@ -0,0 +1,20 @@
+package converter
+
+import (
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository/payloads/dao"
+)
+
+// ToPayloadsByMessageFromRepo groups repository layer models by message ID as structures of service layer.
+func ToPayloadsByMessageFromRepo(payloads []*dao.Payload) map[string][]*model.DevicePayload {
+	res := make(map[string][]*model.DevicePayload)
+	for _, p := range payloads {
+		res[p.MessageID] = append(res[p.MessageID], &model.DevicePayload{
+			Recipient:  p.Recipient,
+			DeviceID:   p.DeviceID,
+			Ciphertext: p.Ciphertext,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,9 @@
+package dao
+
+// Payload type is the main structure for message payload encrypted for a recipient device.
+type Payload struct {
+	MessageID  string `db:"message_id"`
+	Recipient  string `db:"recipient"`
+	DeviceID   string `db:"device_id"`
+	Ciphertext []byte `db:"ciphertext"`
+}
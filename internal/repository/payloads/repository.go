@@ -0,0 +1,125 @@
+package payloads
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"github.com/8thgencore/microservice-chat/internal/repository/payloads/converter"
+	"github.com/8thgencore/microservice-chat/internal/repository/payloads/dao"
+	"github.com/8thgencore/microservice-common/pkg/db"
+)
+
+const (
+	tableName = "message_payloads"
+
+	messageIDColumn  = "message_id"
+	chatIDColumn     = "chat_id"
+	recipientColumn  = "recipient"
+	deviceIDColumn   = "device_id"
+	ciphertextColumn = "ciphertext"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new object of repository layer.
+func NewRepository(db db.Client) repository.PayloadsRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Create(ctx context.Context, chatID string, messageID string, payloads []*model.DevicePayload) error {
+	chat, err := uuid.Parse(chatID)
+	if err != nil {
+		return err
+	}
+	message, err := uuid.Parse(messageID)
+	if err != nil {
+		return err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(messageIDColumn, chatIDColumn, recipientColumn, deviceIDColumn, ciphertextColumn)
+	for _, p := range payloads {
+		builderInsert = builderInsert.Values(message, chat, p.Recipient, p.DeviceID, p.Ciphertext)
+	}
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "payloads_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repo) ListForRecipient(
+	ctx context.Context,
+	chatID string,
+	recipient string,
+) (map[string][]*model.DevicePayload, error) {
+	chat, err := uuid.Parse(chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(messageIDColumn, recipientColumn, deviceIDColumn, ciphertextColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chat, recipientColumn: recipient})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "payloads_repository.ListForRecipient",
+		QueryRaw: query,
+	}
+
+	var payloads []*dao.Payload
+	err = r.db.DB().ScanAllContext(ctx, &payloads, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToPayloadsByMessageFromRepo(payloads), nil
+}
+
+func (r *repo) DeleteRecipient(ctx context.Context, recipient string) error {
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{recipientColumn: recipient})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "payloads_repository.DeleteRecipient",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package converter
+
+import (
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository/reports/dao"
+)
+
+// ToReportFromRepo converts repository layer model to structure of service layer.
+func ToReportFromRepo(report *dao.Report) *model.Report {
+	res := &model.Report{
+		ID:          report.ID,
+		ChatID:      report.ChatID,
+		MessageID:   report.MessageID,
+		MessageFrom: report.MessageFrom.String,
+		MessageText: report.MessageText.String,
+		Reporter:    report.Reporter,
+		Reason:      report.Reason,
+		Status:      model.ReportStatus(report.Status),
+		ResolvedBy:  report.ResolvedBy.String,
+		CreatedAt:   report.CreatedAt,
+	}
+	if report.ResolvedAt.Valid {
+		res.ResolvedAt = &report.ResolvedAt.Time
+	}
+
+	return res
+}
+
+// ToReportsFromRepo converts repository layer models to structures of service layer.
+func ToReportsFromRepo(reports []*dao.Report) []*model.Report {
+	res := make([]*model.Report, 0, len(reports))
+	for _, r := range reports {
+		res = append(res, ToReportFromRepo(r))
+	}
+
+	return res
+}
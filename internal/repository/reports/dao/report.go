@@ -0,0 +1,23 @@
+package dao
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Report type is the main structure for message report.
+type Report struct {
+	ID          string         `db:"id"`
+	ChatID      string         `db:"chat_id"`
+	MessageID   string         `db:"message_id"`
+	MessageFrom sql.NullString `db:"message_from"`
+	Reporter    string         `db:"reporter"`
+	Reason      string         `db:"reason"`
+	Status      string         `db:"status"`
+	ResolvedBy  sql.NullString `db:"resolved_by"`
+	CreatedAt   time.Time      `db:"created_at"`
+	ResolvedAt  sql.NullTime   `db:"resolved_at"`
+	// MessageText and MessageKeyVersion are read from the reported message, they are NULL once it is deleted.
+	MessageText       sql.NullString `db:"message_text"`
+	MessageKeyVersion sql.NullInt32  `db:"message_key_version"`
+}
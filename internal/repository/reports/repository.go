@@ -0,0 +1,259 @@
+package reports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"github.com/8thgencore/microservice-chat/internal/repository/messages"
+	"github.com/8thgencore/microservice-chat/internal/repository/reports/converter"
+	"github.com/8thgencore/microservice-chat/internal/repository/reports/dao"
+	"github.com/8thgencore/microservice-common/pkg/db"
+)
+
+const (
+	tableName     = "message_reports"
+	reportsAlias  = "r"
+	messagesTable = "messages m"
+
+	idColumn          = "id"
+	chatIDColumn      = "chat_id"
+	messageIDColumn   = "message_id"
+	messageFromColumn = "message_from"
+	reporterColumn    = "reporter"
+	reasonColumn      = "reason"
+	statusColumn      = "status"
+	resolvedByColumn  = "resolved_by"
+	createdAtColumn   = "created_at"
+	resolvedAtColumn  = "resolved_at"
+)
+
+var reportColumns = []string{
+	idColumn, chatIDColumn, messageIDColumn, messageFromColumn, reporterColumn,
+	reasonColumn, statusColumn, resolvedByColumn, createdAtColumn, resolvedAtColumn,
+}
+
+type repo struct {
+	db db.Client
+
+	cipher *messages.TextCipher
+}
+
+// NewRepository creates new object of repository layer.
+// Reports do not copy the message text, it is read from the message and decrypted with the cipher.
+func NewRepository(db db.Client, cipher *messages.TextCipher) repository.ReportsRepository {
+	return &repo{
+		db:     db,
+		cipher: cipher,
+	}
+}
+
+// selectReports selects reports with the text and key version of the reported message.
+func selectReports() sq.SelectBuilder {
+	columns := make([]string, 0, len(reportColumns)+2)
+	for _, c := range reportColumns {
+		columns = append(columns, reportsAlias+"."+c)
+	}
+	columns = append(columns, "m.text AS message_text", "m.key_version AS message_key_version")
+
+	return sq.Select(columns...).
+		From(tableName + " " + reportsAlias).
+		LeftJoin(fmt.Sprintf("%s ON m.id = %s.%s", messagesTable, reportsAlias, messageIDColumn)).
+		PlaceholderFormat(sq.Dollar)
+}
+
+func (r *repo) Create(ctx context.Context, report *model.Report) (string, error) {
+	chatID, err := uuid.Parse(report.ChatID)
+	if err != nil {
+		return "", err
+	}
+	messageID, err := uuid.Parse(report.MessageID)
+	if err != nil {
+		return "", err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatIDColumn, messageIDColumn, messageFromColumn, reporterColumn, reasonColumn).
+		Values(chatID, messageID, report.MessageFrom, report.Reporter, report.Reason).
+		Suffix(fmt.Sprintf("ON CONFLICT DO NOTHING RETURNING %s", idColumn))
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	q := db.Query{
+		Name:     "reports_repository.Create",
+		QueryRaw: query,
+	}
+
+	var id uuid.UUID
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", repository.ErrAlreadyExists
+		}
+		return "", err
+	}
+
+	return id.String(), nil
+}
+
+func (r *repo) Get(ctx context.Context, id string) (*model.Report, error) {
+	i, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := selectReports().
+		Where(sq.Eq{reportsAlias + "." + idColumn: i})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "reports_repository.Get",
+		QueryRaw: query,
+	}
+
+	var report dao.Report
+	err = r.db.DB().ScanOneContext(ctx, &report, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, err
+	}
+	if err = r.decrypt(ctx, []*dao.Report{&report}); err != nil {
+		return nil, err
+	}
+
+	return converter.ToReportFromRepo(&report), nil
+}
+
+func (r *repo) List(ctx context.Context, filter model.ReportFilter) ([]*model.Report, error) {
+	id, err := uuid.Parse(filter.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := selectReports().
+		Where(sq.Eq{reportsAlias + "." + chatIDColumn: id, reportsAlias + "." + statusColumn: filter.Status}).
+		OrderBy(reportsAlias+"."+createdAtColumn, reportsAlias+"."+idColumn).
+		Limit(filter.Limit)
+	if filter.After != nil {
+		builderSelect = builderSelect.Where(
+			sq.Expr(
+				fmt.Sprintf("(%s.%s, %s.%s) > (?, ?)", reportsAlias, createdAtColumn, reportsAlias, idColumn),
+				filter.After.CreatedAt, filter.After.ID,
+			),
+		)
+	}
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "reports_repository.List",
+		QueryRaw: query,
+	}
+
+	var reports []*dao.Report
+	err = r.db.DB().ScanAllContext(ctx, &reports, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.decrypt(ctx, reports); err != nil {
+		return nil, err
+	}
+
+	return converter.ToReportsFromRepo(reports), nil
+}
+
+func (r *repo) Resolve(ctx context.Context, id string, status model.ReportStatus, resolvedBy string) error {
+	i, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(statusColumn, status).
+		Set(resolvedByColumn, resolvedBy).
+		Set(resolvedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{idColumn: i, statusColumn: model.ReportOpen})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "reports_repository.Resolve",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *repo) AnonymizeUser(ctx context.Context, username string, pseudonym string) error {
+	for _, column := range []string{messageFromColumn, reporterColumn, resolvedByColumn} {
+		builderUpdate := sq.Update(tableName).
+			PlaceholderFormat(sq.Dollar).
+			Set(column, pseudonym).
+			Where(sq.Eq{column: username})
+
+		query, args, err := builderUpdate.ToSql()
+		if err != nil {
+			return err
+		}
+
+		q := db.Query{
+			Name:     "reports_repository.AnonymizeUser",
+			QueryRaw: query,
+		}
+
+		_, err = r.db.DB().ExecContext(ctx, q, args...)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decrypt replaces encrypted texts of the reported messages with plaintext.
+func (r *repo) decrypt(ctx context.Context, reports []*dao.Report) error {
+	for _, report := range reports {
+		if !report.MessageText.Valid {
+			continue
+		}
+
+		text, err := r.cipher.Decrypt(ctx, report.ChatID, report.MessageText.String, report.MessageKeyVersion)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt message of report %s: %w", report.ID, err)
+		}
+		report.MessageText.String = text
+	}
+
+	return nil
+}
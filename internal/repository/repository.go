@@ -2,25 +2,197 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/8thgencore/microservice-chat/internal/model"
 )
 
+var (
+	// ErrNotFound is returned when the requested record does not exist.
+	ErrNotFound = errors.New("record not found")
+	// ErrAlreadyExists is returned when the record violates a uniqueness constraint.
+	ErrAlreadyExists = errors.New("record already exists")
+)
+
+// ReencryptResult describes a batch of re-encrypted messages.
+type ReencryptResult struct {
+	// Last is the ID of the last message of the batch, the next batch starts after it.
+	Last string
+	// Count is the number of messages in the batch, failed ones included.
+	Count int
+	// Failed holds errors of messages which were left as they were.
+	Failed []error
+}
+
 // ChatRepository is the interface for chat info repository communication.
 type ChatRepository interface {
 	Create(ctx context.Context, chat *model.Chat) (string, error)
 	Delete(ctx context.Context, id string) error
 	GetChats(ctx context.Context) ([]string, error)
+	Get(ctx context.Context, id string) (*model.Chat, error)
+	SetMessageTTL(ctx context.Context, id string, ttl time.Duration) error
+	SetRetention(ctx context.Context, id string, retention time.Duration) error
+	// RemoveUser removes the user from the usernames of every chat.
+	RemoveUser(ctx context.Context, username string) error
+}
+
+// MembersRepository is the interface for chat members repository communication.
+type MembersRepository interface {
+	// Add stores chat members, existing members keep their roles.
+	Add(ctx context.Context, chatID string, members []*model.Member) error
+	// List returns members of the chat, oldest first.
+	List(ctx context.Context, chatID string) ([]*model.Member, error)
+	// SetRole changes the role of the member. It returns ErrNotFound if the user is not a member.
+	SetRole(ctx context.Context, chatID string, username string, role model.ChatRole) error
+	// ListByUser returns memberships of the user with their join time, oldest first.
+	ListByUser(ctx context.Context, username string) ([]*model.Member, error)
+	// DeleteUser removes the user from every chat and returns IDs of these chats.
+	DeleteUser(ctx context.Context, username string) ([]string, error)
+}
+
+// RestrictionsRepository is the interface for chat bans and mutes repository communication.
+type RestrictionsRepository interface {
+	// Upsert stores the restriction, replacing the expiration of an existing one.
+	Upsert(ctx context.Context, restriction *model.Restriction) error
+	// Delete lifts the restriction. It returns ErrNotFound if the user is not restricted.
+	Delete(ctx context.Context, chatID string, username string, kind model.RestrictionKind) error
+	// List returns restrictions of the user in the chat which have not expired.
+	List(ctx context.Context, chatID string, username string) ([]*model.Restriction, error)
+	// DeleteUser lifts restrictions of the user in every chat.
+	DeleteUser(ctx context.Context, username string) error
 }
 
 // MessagesRepository is the interface for messages info repository communication.
 type MessagesRepository interface {
-	Create(ctx context.Context, chatID string, message *model.Message) error
+	// Create stores the message and returns its ID.
+	// It returns ErrAlreadyExists if the sender already stored a message with the same client message ID in the chat.
+	Create(ctx context.Context, chatID string, message *model.Message) (string, error)
+	GetByClientMessageID(ctx context.Context, chatID string, from string, clientMessageID string) (*model.Message, error)
+	// CreateBatch stores messages with their original authors and timestamps and returns the number of
+	// inserted rows. Messages repeating a client message ID stored by their author are skipped.
+	CreateBatch(ctx context.Context, chatID string, messages []*model.Message) (int, error)
+	// Get returns the message unless it expired. It returns ErrNotFound if there is no such message.
+	Get(ctx context.Context, id string) (*model.Message, error)
+	// Delete removes the message. It returns ErrNotFound if there is no such message.
+	Delete(ctx context.Context, id string) error
+	// GetMessages returns the chat history without expired messages.
 	GetMessages(ctx context.Context, chatID string) ([]*model.Message, error)
 	DeleteChat(ctx context.Context, chatID string) error
+	// DeleteExpired removes up to limit expired messages and returns them.
+	DeleteExpired(ctx context.Context, limit uint64) ([]*model.Message, error)
+	// DeleteOutsideRetention removes up to limit messages older than the retention of their chat.
+	DeleteOutsideRetention(ctx context.Context, defaultRetention time.Duration, limit uint64) ([]*model.Message, error)
+	// CountOutsideRetention returns the number of messages older than the retention per chat ID.
+	CountOutsideRetention(ctx context.Context, defaultRetention time.Duration) (map[string]int, error)
+	// RotateKeys rewraps data keys with the primary master key and adds a new data key version
+	// to chats whose latest key is older than maxAge. It returns the number of new data keys.
+	RotateKeys(ctx context.Context, maxAge time.Duration) (int, error)
+	// Reencrypt encrypts up to limit messages following the after ID which are plaintext or under
+	// an old data key with the latest key of their chat. Messages are ordered by ID, empty after starts
+	// from the first one. Messages which fail are skipped and reported in the result.
+	Reencrypt(ctx context.Context, after string, limit uint64) (*ReencryptResult, error)
+	// ListByAuthor returns up to limit messages of the author following the given one.
+	// Expired messages are skipped like on the read paths, even if the reaper has not deleted them yet.
+	// Messages are ordered by timestamp, nil after starts from the oldest message.
+	ListByAuthor(ctx context.Context, username string, after *model.Message, limit uint64) ([]*model.Message, error)
+	// AnonymizeAuthor replaces the author of the user's messages and returns the number of changed messages.
+	AnonymizeAuthor(ctx context.Context, username string, pseudonym string) (int, error)
+}
+
+// BotsRepository is the interface for bot accounts repository communication.
+// API keys are never stored, only their hashes.
+type BotsRepository interface {
+	// Create stores the bot and returns it. It returns ErrAlreadyExists if the name is taken.
+	Create(ctx context.Context, bot *model.Bot, keyHash string) (*model.Bot, error)
+	// GetByKeyHash returns the active bot with the key. It returns ErrNotFound for unknown or revoked keys.
+	GetByKeyHash(ctx context.Context, keyHash string) (*model.Bot, error)
+	// SetKeyHash replaces the key of the active bot.
+	SetKeyHash(ctx context.Context, id string, keyHash string) error
+	Revoke(ctx context.Context, id string) error
+}
+
+// FlagsRepository is the interface for messages flagged for review repository communication.
+type FlagsRepository interface {
+	Create(ctx context.Context, flags []*model.MessageFlag) error
+}
+
+// ReportsRepository is the interface for reported messages repository communication.
+type ReportsRepository interface {
+	// Create stores the report. It returns ErrAlreadyExists if the user already reported the message.
+	Create(ctx context.Context, report *model.Report) (string, error)
+	Get(ctx context.Context, id string) (*model.Report, error)
+	// List returns up to limit reports matching the filter, oldest first.
+	// Reports carry the current text of the message, which is empty if the message was deleted.
+	List(ctx context.Context, filter model.ReportFilter) ([]*model.Report, error)
+	// Resolve closes the open report. It returns ErrNotFound if there is no open report with the ID.
+	Resolve(ctx context.Context, id string, status model.ReportStatus, resolvedBy string) error
+	// AnonymizeUser replaces the user as reporter, resolver or author of the reported message.
+	AnonymizeUser(ctx context.Context, username string, pseudonym string) error
+}
+
+// BlocksRepository is the interface for user blocks repository communication.
+type BlocksRepository interface {
+	// Create stores the block. It returns ErrAlreadyExists if the user is already blocked.
+	Create(ctx context.Context, blocker string, blocked string) error
+	// Delete removes the block. It returns ErrNotFound if the user is not blocked.
+	Delete(ctx context.Context, blocker string, blocked string) error
+	// List returns users blocked by the blocker, oldest first.
+	List(ctx context.Context, blocker string) ([]*model.Block, error)
+	// ListByBlockers returns users blocked by any of the blockers.
+	ListByBlockers(ctx context.Context, blockers []string) ([]*model.Block, error)
+	// Exists reports whether either of the users blocked the other one.
+	Exists(ctx context.Context, first string, second string) (bool, error)
+	// DeleteUser removes blocks made by or of the user.
+	DeleteUser(ctx context.Context, username string) error
+}
+
+// DevicesRepository is the interface for device public keys repository communication.
+// Revoked keys are kept but never returned.
+type DevicesRepository interface {
+	// Create stores the key. It returns ErrAlreadyExists if the device of the user has an active key.
+	Create(ctx context.Context, key *model.DeviceKey) (*model.DeviceKey, error)
+	// Get returns the active key. It returns ErrNotFound for unknown or revoked keys.
+	Get(ctx context.Context, id string) (*model.DeviceKey, error)
+	// List returns active keys of the user, oldest first.
+	List(ctx context.Context, username string) ([]*model.DeviceKey, error)
+	Revoke(ctx context.Context, id string) error
+	// RevokeDevice revokes the active key of the device. It returns ErrNotFound if the device has none.
+	RevokeDevice(ctx context.Context, username string, deviceID string) error
+	// DeleteUser removes all keys of the user, revoked ones included.
+	DeleteUser(ctx context.Context, username string) error
+}
+
+// PayloadsRepository is the interface for end-to-end encrypted message payloads repository communication.
+type PayloadsRepository interface {
+	Create(ctx context.Context, chatID string, messageID string, payloads []*model.DevicePayload) error
+	// ListForRecipient returns payloads of the chat messages addressed to the recipient by message ID.
+	ListForRecipient(ctx context.Context, chatID string, recipient string) (map[string][]*model.DevicePayload, error)
+	DeleteRecipient(ctx context.Context, recipient string) error
 }
 
 // LogRepository is the interface for transaction log repository communication.
 type LogRepository interface {
+	// Log records the action, the actor and request ID are taken from the context if not set.
 	Log(ctx context.Context, log *model.Log) error
+	// Query returns records matching the filter, newest first.
+	Query(ctx context.Context, filter model.LogFilter) ([]*model.Log, error)
+	// AnonymizeUser replaces the username with the pseudonym in the records of the user's actions
+	// and of actions done to the user.
+	AnonymizeUser(ctx context.Context, username string, pseudonym string) error
+}
+
+// ScheduledRepository is the interface for scheduled messages repository communication.
+type ScheduledRepository interface {
+	Create(ctx context.Context, scheduled *model.ScheduledMessage) (string, error)
+	Get(ctx context.Context, id string) (*model.ScheduledMessage, error)
+	GetPending(ctx context.Context, chatID string) ([]*model.ScheduledMessage, error)
+	Cancel(ctx context.Context, id string) error
+	// ClaimDue locks due pending messages so that concurrent workers skip them.
+	// It must be called inside a transaction, the lock is held until the transaction ends.
+	ClaimDue(ctx context.Context, limit uint64) ([]*model.ScheduledMessage, error)
+	MarkSent(ctx context.Context, id string) error
+	MarkFailed(ctx context.Context, id string, reason string, retryAt time.Time, maxAttempts int) error
+	// DeleteByAuthor removes scheduled messages of the user whatever their status.
+	DeleteByAuthor(ctx context.Context, username string) error
 }
@@ -0,0 +1,38 @@
+package converter
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository/restrictions/dao"
+)
+
+// ToRestrictionsFromRepo converts repository layer models to structures of service layer.
+func ToRestrictionsFromRepo(restrictions []*dao.Restriction) []*model.Restriction {
+	res := make([]*model.Restriction, 0, len(restrictions))
+	for _, r := range restrictions {
+		item := &model.Restriction{
+			ChatID:    r.ChatID,
+			Username:  r.Username,
+			Kind:      model.RestrictionKind(r.Kind),
+			CreatedBy: r.CreatedBy,
+		}
+		if r.ExpiresAt.Valid {
+			item.ExpiresAt = &r.ExpiresAt.Time
+		}
+		res = append(res, item)
+	}
+
+	return res
+}
+
+// ToExpiresAtFromService converts restriction expiration to repository layer value.
+// Restrictions without expiration are stored with NULL.
+func ToExpiresAtFromService(expiresAt *time.Time) sql.NullTime {
+	if expiresAt == nil {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{Time: *expiresAt, Valid: true}
+}
@@ -0,0 +1,12 @@
+package dao
+
+import "database/sql"
+
+// Restriction type is the main structure for chat restriction.
+type Restriction struct {
+	ChatID    string       `db:"chat_id"`
+	Username  string       `db:"username"`
+	Kind      string       `db:"kind"`
+	ExpiresAt sql.NullTime `db:"expires_at"`
+	CreatedBy string       `db:"created_by"`
+}
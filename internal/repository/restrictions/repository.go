@@ -0,0 +1,162 @@
+package restrictions
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"github.com/8thgencore/microservice-chat/internal/repository/restrictions/converter"
+	"github.com/8thgencore/microservice-chat/internal/repository/restrictions/dao"
+	"github.com/8thgencore/microservice-common/pkg/db"
+)
+
+const (
+	tableName = "chat_restrictions"
+
+	chatIDColumn    = "chat_id"
+	usernameColumn  = "username"
+	kindColumn      = "kind"
+	expiresAtColumn = "expires_at"
+	createdByColumn = "created_by"
+	createdAtColumn = "created_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates new object of repository layer.
+func NewRepository(db db.Client) repository.RestrictionsRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Upsert(ctx context.Context, restriction *model.Restriction) error {
+	chatID, err := uuid.Parse(restriction.ChatID)
+	if err != nil {
+		return err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatIDColumn, usernameColumn, kindColumn, expiresAtColumn, createdByColumn).
+		Values(
+			chatID,
+			restriction.Username,
+			restriction.Kind,
+			converter.ToExpiresAtFromService(restriction.ExpiresAt),
+			restriction.CreatedBy,
+		).
+		Suffix(fmt.Sprintf(
+			"ON CONFLICT (%[1]s, %[2]s, %[3]s) DO UPDATE SET %[4]s = EXCLUDED.%[4]s, %[5]s = EXCLUDED.%[5]s, %[6]s = now()",
+			chatIDColumn, usernameColumn, kindColumn, expiresAtColumn, createdByColumn, createdAtColumn,
+		))
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "restrictions_repository.Upsert",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repo) Delete(ctx context.Context, chatID string, username string, kind model.RestrictionKind) error {
+	id, err := uuid.Parse(chatID)
+	if err != nil {
+		return err
+	}
+
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: id, usernameColumn: username, kindColumn: kind})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "restrictions_repository.Delete",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *repo) List(ctx context.Context, chatID string, username string) ([]*model.Restriction, error) {
+	id, err := uuid.Parse(chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(chatIDColumn, usernameColumn, kindColumn, expiresAtColumn, createdByColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: id, usernameColumn: username}).
+		Where(sq.Or{
+			sq.Eq{expiresAtColumn: nil},
+			sq.Expr(expiresAtColumn + " > now()"),
+		})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "restrictions_repository.List",
+		QueryRaw: query,
+	}
+
+	var restrictions []*dao.Restriction
+	err = r.db.DB().ScanAllContext(ctx, &restrictions, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToRestrictionsFromRepo(restrictions), nil
+}
+
+func (r *repo) DeleteUser(ctx context.Context, username string) error {
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{usernameColumn: username})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "restrictions_repository.DeleteUser",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package converter
+
+import (
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository/scheduled/dao"
+)
+
+// ToScheduledMessageFromRepo converts repository layer model to structure of service layer.
+func ToScheduledMessageFromRepo(scheduled *dao.ScheduledMessage) *model.ScheduledMessage {
+	return &model.ScheduledMessage{
+		ID:     scheduled.ID,
+		ChatID: scheduled.ChatID,
+		Message: &model.Message{
+			From:      scheduled.From.String,
+			Text:      scheduled.Text.String,
+			Timestamp: scheduled.SendAt,
+		},
+		SendAt:   scheduled.SendAt,
+		Status:   model.ScheduledStatus(scheduled.Status),
+		Attempts: scheduled.Attempts,
+	}
+}
+
+// ToScheduledMessagesFromRepo converts repository layer models to structures of service layer.
+func ToScheduledMessagesFromRepo(scheduled []*dao.ScheduledMessage) []*model.ScheduledMessage {
+	res := make([]*model.ScheduledMessage, 0, len(scheduled))
+	for _, s := range scheduled {
+		res = append(res, ToScheduledMessageFromRepo(s))
+	}
+
+	return res
+}
@@ -0,0 +1,19 @@
+package dao
+
+import (
+	"database/sql"
+	"time"
+)
+
+// ScheduledMessage type is the main structure for scheduled message.
+type ScheduledMessage struct {
+	ID       string         `db:"id"`
+	ChatID   string         `db:"chat_id"`
+	From     sql.NullString `db:"from_user"`
+	Text     sql.NullString `db:"text"`
+	SendAt   time.Time      `db:"send_at"`
+	Status   string         `db:"status"`
+	Attempts int            `db:"attempts"`
+	// KeyVersion is the chat data key the text is encrypted with, NULL for plaintext.
+	KeyVersion sql.NullInt32 `db:"key_version"`
+}
@@ -0,0 +1,334 @@
+package scheduled
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"github.com/8thgencore/microservice-chat/internal/repository/messages"
+	"github.com/8thgencore/microservice-chat/internal/repository/scheduled/converter"
+	"github.com/8thgencore/microservice-chat/internal/repository/scheduled/dao"
+	"github.com/8thgencore/microservice-common/pkg/db"
+)
+
+const (
+	tableName = "scheduled_messages"
+
+	idColumn         = "id"
+	chatIDColumn     = "chat_id"
+	fromColumn       = "from_user"
+	textColumn       = "text"
+	sendAtColumn     = "send_at"
+	statusColumn     = "status"
+	attemptsColumn   = "attempts"
+	lastErrorColumn  = "last_error"
+	sentAtColumn     = "sent_at"
+	keyVersionColumn = "key_version"
+)
+
+var selectColumns = []string{
+	idColumn, chatIDColumn, fromColumn, textColumn, sendAtColumn, statusColumn, attemptsColumn, keyVersionColumn,
+}
+
+type repo struct {
+	db db.Client
+
+	cipher *messages.TextCipher
+}
+
+// NewRepository creates new object of repository layer.
+// Texts are encrypted with the data keys of chat messages, as they are sent to the chat later.
+func NewRepository(db db.Client, cipher *messages.TextCipher) repository.ScheduledRepository {
+	return &repo{
+		db:     db,
+		cipher: cipher,
+	}
+}
+
+func (r *repo) Create(ctx context.Context, scheduled *model.ScheduledMessage) (string, error) {
+	chatID, err := uuid.Parse(scheduled.ChatID)
+	if err != nil {
+		return "", err
+	}
+
+	text, keyVersion, err := r.cipher.Encrypt(ctx, scheduled.ChatID, scheduled.Message.Text)
+	if err != nil {
+		return "", err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatIDColumn, fromColumn, textColumn, sendAtColumn, keyVersionColumn).
+		Values(chatID, scheduled.Message.From, text, scheduled.SendAt, keyVersion).
+		Suffix(fmt.Sprintf("RETURNING %s", idColumn))
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	q := db.Query{
+		Name:     "scheduled_repository.Create",
+		QueryRaw: query,
+	}
+
+	var id uuid.UUID
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id)
+	if err != nil {
+		return "", err
+	}
+
+	return id.String(), nil
+}
+
+func (r *repo) Get(ctx context.Context, id string) (*model.ScheduledMessage, error) {
+	i, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(selectColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: i})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "scheduled_repository.Get",
+		QueryRaw: query,
+	}
+
+	var scheduled dao.ScheduledMessage
+	err = r.db.DB().ScanOneContext(ctx, &scheduled, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, err
+	}
+	if err = r.decrypt(ctx, []*dao.ScheduledMessage{&scheduled}); err != nil {
+		return nil, err
+	}
+
+	return converter.ToScheduledMessageFromRepo(&scheduled), nil
+}
+
+func (r *repo) GetPending(ctx context.Context, chatID string) ([]*model.ScheduledMessage, error) {
+	id, err := uuid.Parse(chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	builderSelect := sq.Select(selectColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: id, statusColumn: model.ScheduledPending}).
+		OrderBy(sendAtColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "scheduled_repository.GetPending",
+		QueryRaw: query,
+	}
+
+	var scheduled []*dao.ScheduledMessage
+	err = r.db.DB().ScanAllContext(ctx, &scheduled, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.decrypt(ctx, scheduled); err != nil {
+		return nil, err
+	}
+
+	return converter.ToScheduledMessagesFromRepo(scheduled), nil
+}
+
+func (r *repo) Cancel(ctx context.Context, id string) error {
+	i, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	// Only pending messages can be cancelled. A message claimed by a worker is locked,
+	// so this update waits for the delivery to finish and then matches no rows.
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(statusColumn, model.ScheduledCancelled).
+		Where(sq.Eq{idColumn: i, statusColumn: model.ScheduledPending})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "scheduled_repository.Cancel",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *repo) ClaimDue(ctx context.Context, limit uint64) ([]*model.ScheduledMessage, error) {
+	builderSelect := sq.Select(selectColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{statusColumn: model.ScheduledPending}).
+		Where(sq.Expr(sendAtColumn + " <= now()")).
+		OrderBy(sendAtColumn).
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "scheduled_repository.ClaimDue",
+		QueryRaw: query,
+	}
+
+	var scheduled []*dao.ScheduledMessage
+	err = r.db.DB().ScanAllContext(ctx, &scheduled, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.decrypt(ctx, scheduled); err != nil {
+		return nil, err
+	}
+
+	return converter.ToScheduledMessagesFromRepo(scheduled), nil
+}
+
+func (r *repo) MarkSent(ctx context.Context, id string) error {
+	i, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(statusColumn, model.ScheduledSent).
+		Set(sentAtColumn, sq.Expr("now()")).
+		Set(attemptsColumn, sq.Expr(attemptsColumn+" + 1")).
+		Where(sq.Eq{idColumn: i})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "scheduled_repository.MarkSent",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repo) MarkFailed(
+	ctx context.Context,
+	id string,
+	reason string,
+	retryAt time.Time,
+	maxAttempts int,
+) error {
+	i, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	// The message goes back to the queue with a later send time until it runs out of attempts.
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(attemptsColumn, sq.Expr(attemptsColumn+" + 1")).
+		Set(lastErrorColumn, reason).
+		Set(sendAtColumn, retryAt).
+		Set(statusColumn, sq.Expr(
+			fmt.Sprintf("CASE WHEN %s + 1 >= ? THEN ? ELSE %s END", attemptsColumn, statusColumn),
+			maxAttempts, model.ScheduledFailed,
+		)).
+		Where(sq.Eq{idColumn: i, statusColumn: model.ScheduledPending})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "scheduled_repository.MarkFailed",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repo) DeleteByAuthor(ctx context.Context, username string) error {
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{fromColumn: username})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "scheduled_repository.DeleteByAuthor",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// decrypt replaces encrypted texts of the messages with plaintext.
+func (r *repo) decrypt(ctx context.Context, scheduled []*dao.ScheduledMessage) error {
+	for _, m := range scheduled {
+		text, err := r.cipher.Decrypt(ctx, m.ChatID, m.Text.String, m.KeyVersion)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt scheduled message %s: %w", m.ID, err)
+		}
+		m.Text.String = text
+	}
+
+	return nil
+}
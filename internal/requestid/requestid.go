@@ -0,0 +1,17 @@
+// Package requestid keeps the ID correlating logs and audit records of one request in its context.
+package requestid
+
+import "context"
+
+type requestIDKey struct{}
+
+// With returns a copy of the context carrying the request ID.
+func With(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// FromContext returns the request ID stored in the context, empty if there is none.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
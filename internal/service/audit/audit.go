@@ -0,0 +1,64 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/8thgencore/microservice-chat/internal/identity"
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/pagination"
+)
+
+// QueryAuditLog implements service.AuditService.
+func (s *auditService) QueryAuditLog(
+	ctx context.Context,
+	filter model.LogFilter,
+	pageToken string,
+) ([]*model.Log, string, error) {
+	if principal, _ := identity.FromContext(ctx); !principal.IsAdmin() {
+		return nil, "", status.Error(codes.PermissionDenied, "only administrators can read the audit log")
+	}
+
+	if filter.ChatID != "" {
+		if _, err := uuid.Parse(filter.ChatID); err != nil {
+			return nil, "", status.Error(codes.InvalidArgument, "chat id must be a UUID")
+		}
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return nil, "", status.Error(codes.InvalidArgument, "time range start must be before its end")
+	}
+
+	filter.Limit = pagination.PageSize(filter.Limit)
+
+	if pageToken != "" {
+		timestamp, id, err := pagination.DecodeCursor(pageToken)
+		if err != nil {
+			return nil, "", status.Error(codes.InvalidArgument, "invalid page token")
+		}
+		filter.Before = &model.LogCursor{Timestamp: timestamp, ID: id}
+	}
+
+	// One more record tells whether there is a next page
+	pageSize := filter.Limit
+	filter.Limit++
+
+	records, err := s.logRepository.Query(ctx, filter)
+	if err != nil {
+		log.Print(err)
+		return nil, "", errors.New("failed to query audit log")
+	}
+
+	if uint64(len(records)) <= pageSize {
+		return records, "", nil
+	}
+
+	records = records[:pageSize]
+	last := records[len(records)-1]
+
+	return records, pagination.EncodeCursor(last.Timestamp, last.ID), nil
+}
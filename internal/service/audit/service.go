@@ -0,0 +1,17 @@
+package audit
+
+import (
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"github.com/8thgencore/microservice-chat/internal/service"
+)
+
+type auditService struct {
+	logRepository repository.LogRepository
+}
+
+// NewService creates new object of service layer.
+func NewService(logRepository repository.LogRepository) service.AuditService {
+	return &auditService{
+		logRepository: logRepository,
+	}
+}
@@ -0,0 +1,180 @@
+package bot
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/8thgencore/microservice-chat/internal/identity"
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// apiKeyPrefix makes bot keys recognizable, e.g. by secret scanners.
+const apiKeyPrefix = "chatbot_"
+
+// CreateBot implements service.BotService.
+// The name format is validated by the CreateBotRequest rules.
+func (s *botService) CreateBot(ctx context.Context, name string) (*model.Bot, string, error) {
+	principal, err := checkAdmin(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key, hash, err := newAPIKey()
+	if err != nil {
+		log.Print(err)
+		return nil, "", errors.New("failed to create bot")
+	}
+
+	var bot *model.Bot
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		bot, errTx = s.botsRepository.Create(ctx, &model.Bot{Name: name, CreatedBy: principal.Username}, hash)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.logRepository.Log(ctx, &model.Log{
+			Action:  model.ActionBotCreate,
+			Payload: map[string]any{"bot_id": bot.ID, "name": name},
+			Text:    fmt.Sprintf("Created bot %v with id: %v", name, bot.ID),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return nil, "", status.Error(codes.AlreadyExists, "bot name is taken")
+	}
+	if err != nil {
+		log.Print(err)
+		return nil, "", errors.New("failed to create bot")
+	}
+
+	return bot, key, nil
+}
+
+// RotateBotKey implements service.BotService.
+func (s *botService) RotateBotKey(ctx context.Context, id string) (string, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return "", err
+	}
+
+	key, hash, err := newAPIKey()
+	if err != nil {
+		log.Print(err)
+		return "", errors.New("failed to rotate bot key")
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.botsRepository.SetKeyHash(ctx, id, hash)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.logRepository.Log(ctx, &model.Log{
+			Action:  model.ActionBotRotateKey,
+			Payload: map[string]any{"bot_id": id},
+			Text:    fmt.Sprintf("Rotated key of bot with id: %v", id),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return "", status.Error(codes.NotFound, "bot not found")
+	}
+	if err != nil {
+		log.Print(err)
+		return "", errors.New("failed to rotate bot key")
+	}
+
+	return key, nil
+}
+
+// RevokeBot implements service.BotService.
+func (s *botService) RevokeBot(ctx context.Context, id string) error {
+	if _, err := checkAdmin(ctx); err != nil {
+		return err
+	}
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.botsRepository.Revoke(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.logRepository.Log(ctx, &model.Log{
+			Action:  model.ActionBotRevoke,
+			Payload: map[string]any{"bot_id": id},
+			Text:    fmt.Sprintf("Revoked bot with id: %v", id),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return status.Error(codes.NotFound, "bot not found")
+	}
+	if err != nil {
+		log.Print(err)
+		return errors.New("failed to revoke bot")
+	}
+
+	return nil
+}
+
+// Authenticate implements service.BotService.
+func (s *botService) Authenticate(ctx context.Context, apiKey string) (*model.Bot, error) {
+	bot, err := s.botsRepository.GetByKeyHash(ctx, hashAPIKey(apiKey))
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.Unauthenticated, "api key is invalid")
+	}
+	if err != nil {
+		log.Print(err)
+		return nil, errors.New("failed to authenticate bot")
+	}
+
+	return bot, nil
+}
+
+func checkAdmin(ctx context.Context) (*model.Principal, error) {
+	principal, _ := identity.FromContext(ctx)
+	if !principal.IsAdmin() {
+		return nil, status.Error(codes.PermissionDenied, "only administrators can manage bots")
+	}
+
+	return principal, nil
+}
+
+// newAPIKey generates a random API key and its hash.
+// Keys have full entropy, so a fast hash is enough and allows looking them up by hash.
+func newAPIKey() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+
+	key := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(b)
+
+	return key, hashAPIKey(key), nil
+}
+
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
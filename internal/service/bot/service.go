@@ -0,0 +1,26 @@
+package bot
+
+import (
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"github.com/8thgencore/microservice-chat/internal/service"
+	"github.com/8thgencore/microservice-common/pkg/db"
+)
+
+type botService struct {
+	botsRepository repository.BotsRepository
+	logRepository  repository.LogRepository
+	txManager      db.TxManager
+}
+
+// NewService creates new object of service layer.
+func NewService(
+	botsRepository repository.BotsRepository,
+	logRepository repository.LogRepository,
+	txManager db.TxManager,
+) service.BotService {
+	return &botService{
+		botsRepository: botsRepository,
+		logRepository:  logRepository,
+		txManager:      txManager,
+	}
+}
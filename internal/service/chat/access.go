@@ -0,0 +1,257 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+
+	"github.com/8thgencore/microservice-chat/internal/identity"
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// callerUsername resolves the username a request acts as from the authenticated caller.
+// An empty claimed username is replaced with the caller's one, a different one is rejected.
+func callerUsername(ctx context.Context, claimed string) (string, error) {
+	principal, ok := identity.FromContext(ctx)
+	if !ok || principal.Username == "" {
+		return "", status.Error(codes.Unauthenticated, "caller is not authenticated")
+	}
+
+	if claimed != "" && claimed != principal.Username {
+		return "", status.Error(codes.PermissionDenied, "username does not match the authenticated user")
+	}
+
+	return principal.Username, nil
+}
+
+// getChat returns chat info from the cache and loads it from the repository on a miss.
+func (s *chatService) getChat(ctx context.Context, chatID string) (*cachedChat, error) {
+	if item, ok := s.chatCache.get(chatID); ok {
+		return item, nil
+	}
+
+	return s.loadChat(ctx, chatID)
+}
+
+// loadChat reads chat info from the repository and refreshes the cache.
+func (s *chatService) loadChat(ctx context.Context, chatID string) (*cachedChat, error) {
+	chat, err := s.chatRepository.Get(ctx, chatID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "chat not found")
+	}
+	if err != nil {
+		log.Print(err)
+		return nil, errors.New("failed to get chat")
+	}
+
+	members, err := s.membersRepository.List(ctx, chatID)
+	if err != nil {
+		log.Print(err)
+		return nil, errors.New("failed to get chat")
+	}
+
+	return s.chatCache.set(chat, members), nil
+}
+
+// restricted reports whether the user is under an active restriction of the kind in the chat.
+// Restrictions are read from the database rather than the cache, so bans made on other replicas apply at once.
+func (s *chatService) restricted(ctx context.Context, chatID string, username string, kind model.RestrictionKind) (
+	bool,
+	error,
+) {
+	restrictions, err := s.restrictionsRepository.List(ctx, chatID, username)
+	if err != nil {
+		log.Print(err)
+		return false, errors.New("failed to get chat restrictions")
+	}
+
+	return slices.ContainsFunc(restrictions, func(r *model.Restriction) bool { return r.Kind == kind }), nil
+}
+
+// checkMember makes sure the caller is a member of the chat and returns the caller's username.
+// The claimed username is resolved as in callerUsername.
+func (s *chatService) checkMember(ctx context.Context, chatID string, claimed string) (string, *model.Chat, error) {
+	username, item, err := s.member(ctx, chatID, claimed)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return username, item.chat, nil
+}
+
+// checkReader makes sure the caller is a member of the chat who is not banned.
+func (s *chatService) checkReader(ctx context.Context, chatID string, claimed string) (string, *model.Chat, error) {
+	return s.checkRestrictions(ctx, chatID, claimed, model.RestrictionBan)
+}
+
+// checkSender makes sure the caller is a member of the chat who is neither banned nor muted.
+func (s *chatService) checkSender(ctx context.Context, chatID string, claimed string) (string, *model.Chat, error) {
+	return s.checkRestrictions(ctx, chatID, claimed, model.RestrictionBan, model.RestrictionMute)
+}
+
+// restrictionErrors are returned to members under each restriction kind.
+var restrictionErrors = map[model.RestrictionKind]string{
+	model.RestrictionBan:  "caller is banned from the chat",
+	model.RestrictionMute: "caller is muted in the chat",
+}
+
+// checkRestrictions makes sure the caller is a member of the chat who is under none of the restriction kinds.
+func (s *chatService) checkRestrictions(
+	ctx context.Context,
+	chatID string,
+	claimed string,
+	kinds ...model.RestrictionKind,
+) (string, *model.Chat, error) {
+	username, item, err := s.member(ctx, chatID, claimed)
+	if err != nil {
+		return "", nil, err
+	}
+
+	restrictions, err := s.restrictionsRepository.List(ctx, chatID, username)
+	if err != nil {
+		log.Print(err)
+		return "", nil, errors.New("failed to get chat restrictions")
+	}
+
+	for _, kind := range kinds {
+		if slices.ContainsFunc(restrictions, func(r *model.Restriction) bool { return r.Kind == kind }) {
+			return "", nil, status.Error(codes.PermissionDenied, restrictionErrors[kind])
+		}
+	}
+
+	return username, item.chat, nil
+}
+
+func (s *chatService) member(ctx context.Context, chatID string, claimed string) (string, *cachedChat, error) {
+	username, err := callerUsername(ctx, claimed)
+	if err != nil {
+		return "", nil, err
+	}
+
+	item, err := s.getChat(ctx, chatID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if !item.isMember(username) {
+		return "", nil, status.Error(codes.PermissionDenied, "caller is not a member of the chat")
+	}
+
+	return username, item, nil
+}
+
+// Operations gated on the chat role of the caller.
+const (
+	opDeleteChat        = "delete chat"
+	opUpdateSettings    = "update chat settings"
+	opPromoteMember     = "promote member"
+	opDemoteMember      = "demote member"
+	opTransferOwnership = "transfer ownership"
+	opRestrictMember    = "ban or mute member"
+	opModerate          = "review reports"
+)
+
+// requiredRoles is the lowest chat role allowed to perform each operation.
+var requiredRoles = map[string]model.ChatRole{
+	opDeleteChat:        model.ChatRoleOwner,
+	opUpdateSettings:    model.ChatRoleAdmin,
+	opPromoteMember:     model.ChatRoleAdmin,
+	opDemoteMember:      model.ChatRoleOwner,
+	opTransferOwnership: model.ChatRoleOwner,
+	opRestrictMember:    model.ChatRoleAdmin,
+	opModerate:          model.ChatRoleAdmin,
+}
+
+// checkRole makes sure the caller's chat role allows the operation and returns the caller's username.
+// Service administrators may perform any operation, so chats without an owner can still be managed.
+// Roles are read from the database rather than the cache, so role changes made on other replicas apply at once.
+func (s *chatService) checkRole(ctx context.Context, chatID string, op string) (string, *cachedChat, error) {
+	username, err := callerUsername(ctx, "")
+	if err != nil {
+		return "", nil, err
+	}
+
+	item, err := s.loadChat(ctx, chatID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if principal, _ := identity.FromContext(ctx); principal.IsAdmin() {
+		return username, item, nil
+	}
+
+	role, ok := item.role(username)
+	if !ok {
+		return "", nil, status.Error(codes.PermissionDenied, "caller is not a member of the chat")
+	}
+	if !role.AtLeast(requiredRoles[op]) {
+		return "", nil, status.Errorf(codes.PermissionDenied, "chat %s role is required to %s", requiredRoles[op], op)
+	}
+
+	return username, item, nil
+}
+
+// audience is what recipients of an event are checked against, it is resolved once per event.
+type audience struct {
+	// chat holds the roles of the recipients of report events
+	chat *cachedChat
+	// blockers are the recipients who blocked the author of the message
+	blockers map[string]struct{}
+}
+
+// audienceOf resolves the audience of the event among the streams of the chat.
+// Streams including messages of blocked users are left out of the block lookup.
+func (s *chatService) audienceOf(ctx context.Context, chatID string, message *model.Message) *audience {
+	a := &audience{}
+
+	// Reports only reach current moderators, so roles are read like in checkRole
+	if message.Event.ModeratorsOnly() {
+		var err error
+		if a.chat, err = s.loadChat(ctx, chatID); err != nil {
+			log.Printf("failed to get chat moderators: %v", err)
+		}
+		return a
+	}
+
+	s.mxChat.RLock()
+	c := s.chats[chatID]
+	s.mxChat.RUnlock()
+
+	var recipients []string
+	c.m.RLock()
+	for member := range c.streams {
+		if !c.includeBlocked[member] {
+			recipients = append(recipients, member)
+		}
+	}
+	c.m.RUnlock()
+
+	a.blockers = s.blockersOf(ctx, recipients, message)
+
+	return a
+}
+
+// receives reports whether the member's stream should get the event.
+// Report events only go to chat moderators, messages of blocked users are left out unless the stream includes them.
+func (a *audience) receives(member string, stream model.Stream, message *model.Message) bool {
+	if message.Event.ModeratorsOnly() {
+		return a.chat != nil && moderates(stream.Context(), a.chat, member)
+	}
+
+	_, blocked := a.blockers[member]
+	return !blocked
+}
+
+// moderates reports whether the member may review reports of the chat, like checkRole does for opModerate.
+func moderates(ctx context.Context, item *cachedChat, member string) bool {
+	if principal, _ := identity.FromContext(ctx); principal.IsAdmin() {
+		return true
+	}
+
+	role, ok := item.role(member)
+	return ok && role.AtLeast(requiredRoles[opModerate])
+}
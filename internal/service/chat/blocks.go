@@ -0,0 +1,192 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BlockUser implements service.ChatService.
+func (s *chatService) BlockUser(ctx context.Context, username string) error {
+	blocker, err := callerUsername(ctx, "")
+	if err != nil {
+		return err
+	}
+	if username == "" {
+		return status.Error(codes.InvalidArgument, "username must not be empty")
+	}
+	if username == blocker {
+		return status.Error(codes.InvalidArgument, "caller can not block themselves")
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.blocksRepository.Create(ctx, blocker, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.logRepository.Log(ctx, &model.Log{
+			Action:  model.ActionUserBlock,
+			Payload: map[string]any{"username": username},
+			Text:    fmt.Sprintf("Blocked %v by %v", username, blocker),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return status.Error(codes.AlreadyExists, "user is already blocked")
+	}
+	if err != nil {
+		log.Print(err)
+		return errors.New("failed to block user")
+	}
+
+	s.blockCache.invalidate(blocker)
+
+	return nil
+}
+
+// UnblockUser implements service.ChatService.
+func (s *chatService) UnblockUser(ctx context.Context, username string) error {
+	blocker, err := callerUsername(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.blocksRepository.Delete(ctx, blocker, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.logRepository.Log(ctx, &model.Log{
+			Action:  model.ActionUserUnblock,
+			Payload: map[string]any{"username": username},
+			Text:    fmt.Sprintf("Unblocked %v by %v", username, blocker),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return status.Error(codes.NotFound, "user is not blocked")
+	}
+	if err != nil {
+		log.Print(err)
+		return errors.New("failed to unblock user")
+	}
+
+	s.blockCache.invalidate(blocker)
+
+	return nil
+}
+
+// ListBlocked implements service.ChatService.
+func (s *chatService) ListBlocked(ctx context.Context) ([]*model.Block, error) {
+	blocker, err := callerUsername(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, err := s.blocksRepository.List(ctx, blocker)
+	if err != nil {
+		log.Print(err)
+		return nil, errors.New("failed to list blocked users")
+	}
+
+	return blocks, nil
+}
+
+// checkDirectChat refuses a direct chat between users if either of them blocked the other one.
+func (s *chatService) checkDirectChat(ctx context.Context, usernames []string) error {
+	if len(usernames) != 2 {
+		return nil
+	}
+
+	blocked, err := s.blocksRepository.Exists(ctx, usernames[0], usernames[1])
+	if err != nil {
+		log.Print(err)
+		return errors.New("failed to check blocked users")
+	}
+	if blocked {
+		return status.Error(codes.FailedPrecondition, "direct chat can not be created with a blocked user")
+	}
+
+	return nil
+}
+
+// blockedBy returns users blocked by the user. Nothing is hidden if the blocks can not be loaded.
+func (s *chatService) blockedBy(ctx context.Context, blocker string) map[string]struct{} {
+	return s.blockedByEach(ctx, []string{blocker})[blocker]
+}
+
+// blockedByEach returns users blocked by each of the users. Blocks missing from the cache are loaded
+// with one query, so a broadcast does not query the database per recipient.
+func (s *chatService) blockedByEach(ctx context.Context, blockers []string) map[string]map[string]struct{} {
+	res := make(map[string]map[string]struct{}, len(blockers))
+
+	var missing []string
+	for _, blocker := range blockers {
+		if blocked, ok := s.blockCache.get(blocker); ok {
+			res[blocker] = blocked
+		} else {
+			missing = append(missing, blocker)
+		}
+	}
+	if len(missing) == 0 {
+		return res
+	}
+
+	blocks, err := s.blocksRepository.ListByBlockers(ctx, missing)
+	if err != nil {
+		log.Printf("failed to load blocked users: %v", err)
+		return res
+	}
+
+	byBlocker := make(map[string][]*model.Block, len(missing))
+	for _, b := range blocks {
+		byBlocker[b.Blocker] = append(byBlocker[b.Blocker], b)
+	}
+	for _, blocker := range missing {
+		res[blocker] = s.blockCache.set(blocker, byBlocker[blocker])
+	}
+
+	return res
+}
+
+// blockersOf returns the recipients who blocked the author of the message and do not see it.
+func (s *chatService) blockersOf(ctx context.Context, recipients []string, message *model.Message) map[string]struct{} {
+	if message.Event != model.EventMessage || message.From == "" || len(recipients) == 0 {
+		return nil
+	}
+
+	res := make(map[string]struct{})
+	for blocker, blocked := range s.blockedByEach(ctx, recipients) {
+		if _, ok := blocked[message.From]; ok {
+			res[blocker] = struct{}{}
+		}
+	}
+
+	return res
+}
+
+// hidden reports whether the message is left out of the user's stream because its author is blocked.
+func (s *chatService) hidden(ctx context.Context, username string, message *model.Message) bool {
+	if message.Event != model.EventMessage || message.From == "" {
+		return false
+	}
+
+	_, ok := s.blockedBy(ctx, username)[message.From]
+	return ok
+}
@@ -0,0 +1,111 @@
+package chat
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+)
+
+// fakeBlocksRepository keeps blocks and counts queries of the blocks of several users.
+type fakeBlocksRepository struct {
+	noopBlocksRepository
+	blocks  []*model.Block
+	queries int
+}
+
+func (r *fakeBlocksRepository) ListByBlockers(_ context.Context, blockers []string) ([]*model.Block, error) {
+	r.queries++
+
+	var res []*model.Block
+	for _, b := range r.blocks {
+		if slices.Contains(blockers, b.Blocker) {
+			res = append(res, b)
+		}
+	}
+
+	return res, nil
+}
+
+func TestAudienceBlockers(t *testing.T) {
+	tests := []struct {
+		name string
+		// includeBlocked streams get messages of blocked users
+		includeBlocked []string
+		message        *model.Message
+		wantReceivers  []string
+		wantQueries    int
+	}{
+		{
+			name:          "blocked author",
+			message:       &model.Message{From: "alice", Event: model.EventMessage},
+			wantReceivers: []string{"alice", "dave"},
+			wantQueries:   1,
+		},
+		{
+			name:           "stream including blocked users",
+			includeBlocked: []string{"bob"},
+			message:        &model.Message{From: "alice", Event: model.EventMessage},
+			wantReceivers:  []string{"alice", "bob", "dave"},
+			wantQueries:    1,
+		},
+		{
+			name:          "author blocked by nobody",
+			message:       &model.Message{From: "dave", Event: model.EventMessage},
+			wantReceivers: []string{"alice", "bob", "carol", "dave"},
+			wantQueries:   1,
+		},
+		{
+			name:          "event without author",
+			message:       &model.Message{Event: model.EventDeleted},
+			wantReceivers: []string{"alice", "bob", "carol", "dave"},
+			wantQueries:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFakeDB()
+			f.addChat("chat", "alice", "bob", "carol", "dave")
+			s := newTestService(f)
+			repo := &fakeBlocksRepository{blocks: []*model.Block{
+				{Blocker: "bob", Blocked: "alice"},
+				{Blocker: "carol", Blocked: "alice"},
+				{Blocker: "alice", Blocked: "carol"},
+			}}
+			s.blocksRepository = repo
+
+			listen(t, s, "chat")
+			c := s.chats["chat"]
+			streams := make(map[string]model.Stream)
+			for _, member := range f.chats["chat"].Usernames {
+				stream := &fakeStream{ctx: asUser(member, "")}
+				streams[member] = stream
+				c.streams[member] = stream
+				c.includeBlocked[member] = slices.Contains(tt.includeBlocked, member)
+			}
+			delete(c.streams, "listener")
+
+			// A second event is served from the cache
+			for range 2 {
+				recipients := s.audienceOf(context.Background(), "chat", tt.message)
+
+				var receivers []string
+				for _, member := range slices.Sorted(maps.Keys(streams)) {
+					if recipients.receives(member, streams[member], tt.message) {
+						receivers = append(receivers, member)
+					}
+				}
+				if !slices.Equal(receivers, tt.wantReceivers) {
+					t.Errorf("receivers = %v, want %v", receivers, tt.wantReceivers)
+				}
+			}
+
+			if repo.queries != tt.wantQueries {
+				t.Errorf("blocks were queried %d times, want %d", repo.queries, tt.wantQueries)
+			}
+		})
+	}
+}
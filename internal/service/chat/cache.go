@@ -0,0 +1,175 @@
+package chat
+
+import (
+	"sync"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+)
+
+// chatCache keeps chat info used on every chat-scoped call, so membership checks do not query the database.
+// Entries expire after ttl, which bounds staleness of changes made by other replicas.
+// Restrictions are not cached and role checks reload the chat, so bans and demotions apply on every replica at once.
+// Expired entries are swept on writes at most once per ttl, so chats which are not used any more are dropped.
+type chatCache struct {
+	ttl time.Duration
+
+	mu        sync.RWMutex
+	items     map[string]*cachedChat
+	nextSweep time.Time
+}
+
+type cachedChat struct {
+	chat      *model.Chat
+	members   map[string]model.ChatRole
+	expiresAt time.Time
+}
+
+func newChatCache(ttl time.Duration) *chatCache {
+	return &chatCache{
+		ttl:   ttl,
+		items: make(map[string]*cachedChat),
+	}
+}
+
+func (c *chatCache) get(chatID string) (*cachedChat, bool) {
+	c.mu.RLock()
+	item, ok := c.items[chatID]
+	c.mu.RUnlock()
+
+	if !ok || time.Now().After(item.expiresAt) {
+		return nil, false
+	}
+
+	return item, true
+}
+
+func (c *chatCache) set(chat *model.Chat, members []*model.Member) *cachedChat {
+	item := &cachedChat{
+		chat:      chat,
+		members:   make(map[string]model.ChatRole, len(members)),
+		expiresAt: time.Now().Add(c.ttl),
+	}
+	for _, m := range members {
+		item.members[m.Username] = m.Role
+	}
+
+	c.mu.Lock()
+	c.sweep()
+	c.items[chat.ID] = item
+	c.mu.Unlock()
+
+	return item
+}
+
+func (c *chatCache) invalidate(chatID string) {
+	c.mu.Lock()
+	delete(c.items, chatID)
+	c.mu.Unlock()
+}
+
+// sweep removes expired entries unless they were swept less than ttl ago. The caller holds the lock.
+func (c *chatCache) sweep() {
+	now := time.Now()
+	if now.Before(c.nextSweep) {
+		return
+	}
+
+	for id, item := range c.items {
+		if now.After(item.expiresAt) {
+			delete(c.items, id)
+		}
+	}
+	c.nextSweep = now.Add(c.ttl)
+}
+
+func (c *cachedChat) isMember(username string) bool {
+	_, ok := c.members[username]
+	return ok
+}
+
+// role returns the chat role of the user and whether the user is a member.
+func (c *cachedChat) role(username string) (model.ChatRole, bool) {
+	role, ok := c.members[username]
+	return role, ok
+}
+
+// owner returns the username of the chat owner, chats left without members have none.
+func (c *cachedChat) owner() string {
+	for username, role := range c.members {
+		if role == model.ChatRoleOwner {
+			return username
+		}
+	}
+
+	return ""
+}
+
+// blockCache keeps users blocked by each connected user, so broadcasts do not query the database.
+// Entries expire after ttl and are swept like chatCache ones.
+type blockCache struct {
+	ttl time.Duration
+
+	mu        sync.RWMutex
+	items     map[string]*cachedBlocks
+	nextSweep time.Time
+}
+
+type cachedBlocks struct {
+	blocked   map[string]struct{}
+	expiresAt time.Time
+}
+
+func newBlockCache(ttl time.Duration) *blockCache {
+	return &blockCache{
+		ttl:   ttl,
+		items: make(map[string]*cachedBlocks),
+	}
+}
+
+func (c *blockCache) get(blocker string) (map[string]struct{}, bool) {
+	c.mu.RLock()
+	item, ok := c.items[blocker]
+	c.mu.RUnlock()
+
+	if !ok || time.Now().After(item.expiresAt) {
+		return nil, false
+	}
+
+	return item.blocked, true
+}
+
+func (c *blockCache) set(blocker string, blocks []*model.Block) map[string]struct{} {
+	blocked := make(map[string]struct{}, len(blocks))
+	for _, b := range blocks {
+		blocked[b.Blocked] = struct{}{}
+	}
+
+	c.mu.Lock()
+	c.sweep()
+	c.items[blocker] = &cachedBlocks{blocked: blocked, expiresAt: time.Now().Add(c.ttl)}
+	c.mu.Unlock()
+
+	return blocked
+}
+
+func (c *blockCache) invalidate(blocker string) {
+	c.mu.Lock()
+	delete(c.items, blocker)
+	c.mu.Unlock()
+}
+
+// sweep removes expired entries unless they were swept less than ttl ago. The caller holds the lock.
+func (c *blockCache) sweep() {
+	now := time.Now()
+	if now.Before(c.nextSweep) {
+		return
+	}
+
+	for blocker, item := range c.items {
+		if now.After(item.expiresAt) {
+			delete(c.items, blocker)
+		}
+	}
+	c.nextSweep = now.Add(c.ttl)
+}
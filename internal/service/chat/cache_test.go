@@ -0,0 +1,66 @@
+package chat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+)
+
+func TestChatCacheSweep(t *testing.T) {
+	tests := []struct {
+		name string
+		// age is how long ago the stale entry was cached
+		age time.Duration
+		// swept is how long ago the cache was last swept
+		swept     time.Duration
+		wantItems int
+	}{
+		{name: "expired entry is swept", age: 2 * time.Minute, swept: 2 * time.Minute, wantItems: 1},
+		{name: "fresh entry is kept", age: 30 * time.Second, swept: 2 * time.Minute, wantItems: 2},
+		{name: "sweep waits for ttl", age: 2 * time.Minute, swept: 30 * time.Second, wantItems: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newChatCache(time.Minute)
+			c.set(&model.Chat{ID: "stale"}, nil)
+			c.items["stale"].expiresAt = time.Now().Add(time.Minute - tt.age)
+			c.nextSweep = time.Now().Add(time.Minute - tt.swept)
+
+			c.set(&model.Chat{ID: "new"}, nil)
+
+			if len(c.items) != tt.wantItems {
+				t.Errorf("cached %d chats, want %d", len(c.items), tt.wantItems)
+			}
+		})
+	}
+}
+
+func TestBlockCacheSweep(t *testing.T) {
+	tests := []struct {
+		name      string
+		age       time.Duration
+		swept     time.Duration
+		wantItems int
+	}{
+		{name: "expired entry is swept", age: 2 * time.Minute, swept: 2 * time.Minute, wantItems: 1},
+		{name: "fresh entry is kept", age: 30 * time.Second, swept: 2 * time.Minute, wantItems: 2},
+		{name: "sweep waits for ttl", age: 2 * time.Minute, swept: 30 * time.Second, wantItems: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newBlockCache(time.Minute)
+			c.set("stale", nil)
+			c.items["stale"].expiresAt = time.Now().Add(time.Minute - tt.age)
+			c.nextSweep = time.Now().Add(time.Minute - tt.swept)
+
+			c.set("new", nil)
+
+			if len(c.items) != tt.wantItems {
+				t.Errorf("cached blocks of %d users, want %d", len(c.items), tt.wantItems)
+			}
+		})
+	}
+}
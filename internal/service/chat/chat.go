@@ -5,40 +5,54 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
+	"time"
 
 	"github.com/8thgencore/microservice-chat/internal/converter"
+	"github.com/8thgencore/microservice-chat/internal/filter"
 	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Connect implements service.ChatService.
-func (s *chatService) Connect(chatID string, username string, stream model.Stream) error {
-	s.mxChannels.RLock()
-	chatChan, ok := s.channels[chatID]
-	s.mxChannels.RUnlock()
-
-	if !ok {
-		return errors.New("chat not found")
+func (s *chatService) Connect(chatID string, username string, includeBlocked bool, stream model.Stream) error {
+	username, _, err := s.checkReader(stream.Context(), chatID, username)
+	if err != nil {
+		return err
 	}
 
+	chatChan := s.channel(chatID)
+
 	// Init streams for chat, if they don't exist
 	s.mxChat.Lock()
 	if _, okChat := s.chats[chatID]; !okChat {
 		s.chats[chatID] = &chat{
-			streams: make(map[string]model.Stream),
+			streams:        make(map[string]model.Stream),
+			kicks:          make(map[string]chan struct{}),
+			includeBlocked: make(map[string]bool),
 		}
 	}
 	s.mxChat.Unlock()
 
 	// Set stream for user
+	kick := make(chan struct{})
 	s.chats[chatID].m.Lock()
 	s.chats[chatID].streams[username] = stream
+	s.chats[chatID].kicks[username] = kick
+	s.chats[chatID].includeBlocked[username] = includeBlocked
 	s.chats[chatID].m.Unlock()
 
-	if err := s.loadHistory(chatID, stream); err != nil {
+	if err := s.loadHistory(chatID, username, includeBlocked, stream); err != nil {
 		// If history not loaded, there's no problem, you can still send messages
 		log.Printf("failed to load history: %v", err)
 	}
 
+	banCheck := time.NewTicker(banCheckInterval)
+	defer banCheck.Stop()
+
 	for {
 		select {
 		case msg, okCh := <-chatChan:
@@ -47,29 +61,71 @@ func (s *chatService) Connect(chatID string, username string, stream model.Strea
 				return nil
 			}
 
+			// Message could expire while waiting in the buffer
+			if msg.Event == model.EventMessage && msg.Expired(time.Now()) {
+				continue
+			}
+
+			// Roles and blocks of the recipients are looked up once for the whole chat
+			recipients := s.audienceOf(stream.Context(), chatID, msg)
+
 			// Send message for everyone in chat
-			for _, st := range s.chats[chatID].streams {
-				if err := st.Send(converter.ToMessageFromService(msg)); err != nil {
+			for member, st := range s.chats[chatID].streams {
+				if !recipients.receives(member, st, msg) {
+					continue
+				}
+				if err := st.Send(converter.ToMessageFromService(msg.ForRecipient(member))); err != nil {
 					return err
 				}
 			}
+		case <-kick:
+			return status.Error(codes.PermissionDenied, "caller is banned from the chat")
+		case <-banCheck.C:
+			// A failed check keeps the stream, it is retried on the next tick
+			if banned, err := s.restricted(stream.Context(), chatID, username, model.RestrictionBan); err == nil && banned {
+				s.disconnect(chatID, username)
+				return status.Error(codes.PermissionDenied, "caller is banned from the chat")
+			}
 		case <-stream.Context().Done():
 			// Delete stream for user when context is dead
 			s.chats[chatID].m.Lock()
 			delete(s.chats[chatID].streams, username)
+			delete(s.chats[chatID].includeBlocked, username)
+			if s.chats[chatID].kicks[username] == kick {
+				delete(s.chats[chatID].kicks, username)
+			}
 			s.chats[chatID].m.Unlock()
 			return nil
 		}
 	}
 }
 
-func (s *chatService) loadHistory(chatID string, stream model.Stream) error {
+// loadHistory sends the chat history, messages of users blocked by the member are left out unless included.
+// Messages of end-to-end encrypted chats carry the payloads addressed to the member's devices.
+func (s *chatService) loadHistory(chatID string, username string, includeBlocked bool, stream model.Stream) error {
 	messages, err := s.messagesRepository.GetMessages(stream.Context(), chatID)
 	if err != nil {
 		return err
 	}
 
+	item, err := s.getChat(stream.Context(), chatID)
+	if err != nil {
+		return err
+	}
+
+	var payloads map[string][]*model.DevicePayload
+	if item.chat.E2E {
+		payloads, err = s.payloadsRepository.ListForRecipient(stream.Context(), chatID, username)
+		if err != nil {
+			return err
+		}
+	}
+
 	for _, msg := range messages {
+		if !includeBlocked && s.hidden(stream.Context(), username, msg) {
+			continue
+		}
+		msg.Payloads = payloads[msg.ID]
 		if err := stream.Send(converter.ToMessageFromService(msg)); err != nil {
 			return err
 		}
@@ -98,15 +154,44 @@ func (s *chatService) Create(ctx context.Context, chat *model.Chat) (string, err
 		return "", errors.New("messagesRepository is not initialized")
 	}
 
-	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+	// The creator always becomes a member, otherwise the chat would be unreachable for them
+	creator, err := callerUsername(ctx, "")
+	if err != nil {
+		return "", err
+	}
+	if !slices.Contains(chat.Usernames, creator) {
+		chat.Usernames = append(chat.Usernames, creator)
+	}
+
+	// The creator owns the chat, everyone else joins as a regular member
+	members := []*model.Member{{Username: creator, Role: model.ChatRoleOwner}}
+	for _, username := range chat.Usernames {
+		if username != creator {
+			members = append(members, &model.Member{Username: username, Role: model.ChatRoleMember})
+		}
+	}
+
+	if err = s.checkDirectChat(ctx, chat.Usernames); err != nil {
+		return "", err
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
 		var errTx error
 		id, errTx = s.chatRepository.Create(ctx, chat)
 		if errTx != nil {
 			return errTx
 		}
 
+		errTx = s.membersRepository.Add(ctx, id, members)
+		if errTx != nil {
+			return errTx
+		}
+
 		errTx = s.logRepository.Log(ctx, &model.Log{
-			Text: fmt.Sprintf("Created chat with id: %v", id),
+			Action:  model.ActionChatCreate,
+			ChatID:  id,
+			Payload: map[string]any{"usernames": chat.Usernames},
+			Text:    fmt.Sprintf("Created chat with id: %v", id),
 		})
 		if errTx != nil {
 			return errTx
@@ -120,13 +205,19 @@ func (s *chatService) Create(ctx context.Context, chat *model.Chat) (string, err
 	}
 
 	// Create buffered channel for new chat
+	s.mxChannels.Lock()
 	s.channels[id] = make(chan *model.Message, messagesBuffer)
+	s.mxChannels.Unlock()
 
 	return id, nil
 }
 
 // Delete implements service.ChatService.
 func (s *chatService) Delete(ctx context.Context, id string) error {
+	if _, _, err := s.checkRole(ctx, id, opDeleteChat); err != nil {
+		return err
+	}
+
 	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
 		var errTx error
 		errTx = s.messagesRepository.DeleteChat(ctx, id)
@@ -140,7 +231,9 @@ func (s *chatService) Delete(ctx context.Context, id string) error {
 		}
 
 		errTx = s.logRepository.Log(ctx, &model.Log{
-			Text: fmt.Sprintf("Deleted chat with id: %v", id),
+			Action: model.ActionChatDelete,
+			ChatID: id,
+			Text:   fmt.Sprintf("Deleted chat with id: %v", id),
 		})
 		if errTx != nil {
 			return errTx
@@ -154,7 +247,11 @@ func (s *chatService) Delete(ctx context.Context, id string) error {
 	}
 
 	// Delete channel associated with chat
+	s.mxChannels.Lock()
 	delete(s.channels, id)
+	s.mxChannels.Unlock()
+
+	s.chatCache.invalidate(id)
 
 	return nil
 }
@@ -176,22 +273,211 @@ func (s *chatService) InitChannels(ctx context.Context) error {
 }
 
 // SendMessage implements service.ChatService.
-func (s *chatService) SendMessage(ctx context.Context, chatID string, message *model.Message) error {
+func (s *chatService) SendMessage(
+	ctx context.Context,
+	chatID string,
+	message *model.Message,
+) (*model.Message, bool, error) {
+	stored, duplicate, err := s.storeMessage(ctx, chatID, message, true)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// A retried message was broadcast when it was stored
+	if !duplicate {
+		s.publish(chatID, stored)
+	}
+
+	return stored, duplicate, nil
+}
+
+// storeMessage checks and stores the message without broadcasting it.
+// The chat is looked up in the repository, so chats created by other replicas are found.
+// Spam detection is skipped for messages which were checked when they were scheduled.
+func (s *chatService) storeMessage(
+	ctx context.Context,
+	chatID string,
+	message *model.Message,
+	detectSpam bool,
+) (*model.Message, bool, error) {
+	if message.Timestamp.Unix() <= 0 {
+		return nil, false, invalidField("message.timestamp", "message timestamp is required")
+	}
+	if message.TTL < 0 {
+		return nil, false, errors.New("message ttl must not be negative")
+	}
+
+	from, chat, err := s.checkSender(ctx, chatID, message.From)
+	if err != nil {
+		return nil, false, err
+	}
+	message.From = from
+
+	// A retried message is answered before spam detection, so the retry does not count as a copy
+	if message.ClientMessageID != "" {
+		stored, errGet := s.messagesRepository.GetByClientMessageID(ctx, chatID, from, message.ClientMessageID)
+		if errGet == nil {
+			return stored, true, nil
+		}
+		if !errors.Is(errGet, repository.ErrNotFound) {
+			log.Print(errGet)
+			return nil, false, errors.New("failed to get stored message")
+		}
+	}
+
+	// Message ttl takes precedence over the chat disappearing-message timer
+	ttl := message.TTL
+	if ttl == 0 {
+		ttl = chat.MessageTTL
+	}
+	if ttl > 0 {
+		message.ExpiresAt = time.Now().Add(ttl)
+	}
+
+	if err = s.checkPayloads(ctx, chatID, chat, message); err != nil {
+		return nil, false, err
+	}
+
+	// Text of end-to-end encrypted messages is empty, so spam detection only limits their rate
+	if detectSpam {
+		if err = s.checkSpam(ctx, chatID, from, message.Text); err != nil {
+			return nil, false, err
+		}
+	}
+
+	// Filters may hide parts of the text or refuse the message before it is stored,
+	// end-to-end encrypted messages have no text to filter
+	result := &filter.Result{}
+	if !chat.E2E {
+		result = s.filter.Apply(message.Text)
+		if result.Rejected {
+			return nil, false, status.Errorf(codes.InvalidArgument, "message is rejected: %s", result.Reason)
+		}
+		message.Text = result.Text
+	}
+
+	// Save message in repository
+	message.ChatID = chatID
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		message.ID, errTx = s.messagesRepository.Create(ctx, chatID, message)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.logRepository.Log(ctx, &model.Log{
+			Action:    model.ActionMessageSend,
+			ChatID:    chatID,
+			MessageID: message.ID,
+			Payload:   map[string]any{"flags": result.Flags, "client_message_id": message.ClientMessageID},
+			Text:      fmt.Sprintf("Sent message with id: %v to chat with id: %v", message.ID, chatID),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		if len(message.Payloads) > 0 {
+			errTx = s.payloadsRepository.Create(ctx, chatID, message.ID, message.Payloads)
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		if len(result.Flags) == 0 {
+			return nil
+		}
+
+		flags := make([]*model.MessageFlag, 0, len(result.Flags))
+		for _, reason := range result.Flags {
+			flags = append(flags, &model.MessageFlag{MessageID: message.ID, ChatID: chatID, Reason: reason})
+		}
+
+		return s.flagsRepository.Create(ctx, flags)
+	})
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		// Concurrent retry is answered with the stored one and is not broadcast again
+		stored, errGet := s.messagesRepository.GetByClientMessageID(ctx, chatID, from, message.ClientMessageID)
+		if errGet != nil {
+			log.Print(errGet)
+			return nil, false, errors.New("failed to get stored message")
+		}
+
+		return stored, true, nil
+	}
+	if err != nil {
+		log.Print(err)
+		return nil, false, errors.New("failed to save message")
+	}
+
+	return message, false, nil
+}
+
+// channel returns the broadcast channel of the chat, creating it for chats found after the start.
+// It must only be called for chats known to exist.
+func (s *chatService) channel(chatID string) chan *model.Message {
+	s.mxChannels.RLock()
+	chatChan, ok := s.channels[chatID]
+	s.mxChannels.RUnlock()
+
+	if ok {
+		return chatChan
+	}
+
+	s.mxChannels.Lock()
+	defer s.mxChannels.Unlock()
+
+	if chatChan, ok = s.channels[chatID]; !ok {
+		chatChan = make(chan *model.Message, messagesBuffer)
+		s.channels[chatID] = chatChan
+	}
+
+	return chatChan
+}
+
+// publish passes the message to the streams connected to the chat.
+// The channel is only read while somebody is connected, so the message is dropped
+// instead of blocking the sender when nobody is listening; it stays available in history.
+func (s *chatService) publish(chatID string, message *model.Message) {
 	s.mxChannels.RLock()
 	chatChan, ok := s.channels[chatID]
 	s.mxChannels.RUnlock()
 
 	if !ok {
-		return errors.New("chat not found")
+		return
 	}
 
-	// Save message in repository
-	err := s.messagesRepository.Create(ctx, chatID, message)
-	if err != nil {
-		return errors.New("failed to save message")
+	s.mxChat.RLock()
+	c, okChat := s.chats[chatID]
+	s.mxChat.RUnlock()
+
+	if !okChat {
+		return
 	}
 
-	chatChan <- message
+	c.m.RLock()
+	listeners := len(c.streams)
+	c.m.RUnlock()
 
-	return nil
+	if listeners == 0 {
+		return
+	}
+
+	select {
+	case chatChan <- message:
+	default:
+		log.Printf("chat %v buffer is full, message is not broadcast", chatID)
+	}
+}
+
+// invalidField reports a rule which can not be expressed in the proto as InvalidArgument with BadRequest details,
+// like the validate interceptor reports violations of proto rules.
+func invalidField(field string, description string) error {
+	st, err := status.New(codes.InvalidArgument, description).WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}},
+	})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, description)
+	}
+
+	return st.Err()
 }
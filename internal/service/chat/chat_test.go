@@ -0,0 +1,160 @@
+package chat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSendMessageDeduplication(t *testing.T) {
+	type send struct {
+		from            string
+		clientMessageID string
+		text            string
+	}
+
+	tests := []struct {
+		name  string
+		sends []send
+		// wantDuplicate is the duplicate flag of every send
+		wantDuplicate []bool
+		// wantText is the text returned for every send
+		wantText     []string
+		wantMessages int
+	}{
+		{
+			name:          "no client message id",
+			sends:         []send{{"alice", "", "hi"}, {"alice", "", "hi"}},
+			wantDuplicate: []bool{false, false},
+			wantText:      []string{"hi", "hi"},
+			wantMessages:  2,
+		},
+		{
+			name:          "retry returns the stored message",
+			sends:         []send{{"alice", "1", "hi"}, {"alice", "1", "changed"}},
+			wantDuplicate: []bool{false, true},
+			wantText:      []string{"hi", "hi"},
+			wantMessages:  1,
+		},
+		{
+			name:          "different ids",
+			sends:         []send{{"alice", "1", "hi"}, {"alice", "2", "hi"}},
+			wantDuplicate: []bool{false, false},
+			wantText:      []string{"hi", "hi"},
+			wantMessages:  2,
+		},
+		{
+			name:          "same id of another sender",
+			sends:         []send{{"alice", "1", "mine"}, {"bob", "1", "yours"}},
+			wantDuplicate: []bool{false, false},
+			wantText:      []string{"mine", "yours"},
+			wantMessages:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFakeDB()
+			f.addChat("chat", "alice", "bob")
+
+			s := newTestService(f)
+			ch := listen(t, s, "chat")
+
+			broadcast := 0
+			for i, m := range tt.sends {
+				stored, duplicate, err := s.SendMessage(asUser(m.from, ""), "chat", &model.Message{
+					Text:            m.text,
+					Timestamp:       time.Now(),
+					ClientMessageID: m.clientMessageID,
+				})
+				if err != nil {
+					t.Fatalf("send %d: SendMessage() error = %v", i, err)
+				}
+				if duplicate != tt.wantDuplicate[i] {
+					t.Errorf("send %d: duplicate = %v, want %v", i, duplicate, tt.wantDuplicate[i])
+				}
+				if stored.Text != tt.wantText[i] {
+					t.Errorf("send %d: text = %q, want %q", i, stored.Text, tt.wantText[i])
+				}
+				if !duplicate {
+					broadcast++
+				}
+			}
+
+			if len(f.messages) != tt.wantMessages {
+				t.Errorf("stored %d messages, want %d", len(f.messages), tt.wantMessages)
+			}
+			// Retries are answered without broadcasting them again
+			if got := broadcasts(ch); len(got) != broadcast {
+				t.Errorf("broadcast %d messages, want %d", len(got), broadcast)
+			}
+		})
+	}
+}
+
+func TestMessageFieldViolations(t *testing.T) {
+	tests := []struct {
+		name      string
+		call      func(s *chatService) error
+		wantField string
+	}{
+		{
+			name: "send without timestamp",
+			call: func(s *chatService) error {
+				_, _, err := s.SendMessage(asUser("alice", ""), "chat", &model.Message{Text: "hi"})
+				return err
+			},
+			wantField: "message.timestamp",
+		},
+		{
+			name: "import without timestamp",
+			call: func(s *chatService) error {
+				_, _, err := s.ImportMessages(asUser("root", model.RoleAdmin), "chat", []*model.Message{
+					{From: "alice", Text: "hi", Timestamp: time.Now()},
+					{From: "bob", Text: "hi"},
+				})
+				return err
+			},
+			wantField: "messages[1].timestamp",
+		},
+		{
+			name: "import without author",
+			call: func(s *chatService) error {
+				_, _, err := s.ImportMessages(asUser("root", model.RoleAdmin), "chat", []*model.Message{
+					{Text: "hi", Timestamp: time.Now()},
+				})
+				return err
+			},
+			wantField: "messages[0].from",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFakeDB()
+			f.addChat("chat", "alice", "bob")
+			s := newTestService(f)
+
+			st := status.Convert(tt.call(s))
+			if st.Code() != codes.InvalidArgument {
+				t.Fatalf("code = %v, want %v", st.Code(), codes.InvalidArgument)
+			}
+
+			var fields []string
+			for _, detail := range st.Details() {
+				if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+					for _, v := range badRequest.GetFieldViolations() {
+						fields = append(fields, v.GetField())
+					}
+				}
+			}
+			if len(fields) != 1 || fields[0] != tt.wantField {
+				t.Errorf("field violations = %v, want [%v]", fields, tt.wantField)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// plaintextRequired refuses features which need the server to read message texts.
+func plaintextRequired(feature string) error {
+	return status.Errorf(codes.FailedPrecondition, "%s is not available in end-to-end encrypted chats", feature)
+}
+
+// checkPayloads makes sure the message fits the chat: end-to-end encrypted chats only take device payloads
+// addressed to their members, other chats only take plain text. Ciphertexts are never inspected.
+// Payload counts and sizes are limited by the proto rules.
+func (s *chatService) checkPayloads(
+	ctx context.Context,
+	chatID string,
+	chat *model.Chat,
+	message *model.Message,
+) error {
+	if !chat.E2E {
+		if len(message.Payloads) > 0 {
+			return status.Error(codes.InvalidArgument, "device payloads are only accepted in end-to-end encrypted chats")
+		}
+		return nil
+	}
+
+	if message.Text != "" {
+		return status.Error(codes.InvalidArgument, "end-to-end encrypted chats do not accept plain text")
+	}
+	if len(message.Payloads) == 0 {
+		return status.Error(codes.InvalidArgument, "message must carry device payloads")
+	}
+
+	item, err := s.getChat(ctx, chatID)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(message.Payloads))
+	for n, p := range message.Payloads {
+		if !item.isMember(p.Recipient) {
+			return status.Errorf(codes.InvalidArgument, "payload %d recipient is not a member of the chat", n)
+		}
+
+		key := fmt.Sprintf("%s\x00%s", p.Recipient, p.DeviceID)
+		if _, ok := seen[key]; ok {
+			return status.Errorf(codes.InvalidArgument, "payload %d repeats a recipient device", n)
+		}
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
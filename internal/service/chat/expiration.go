@@ -0,0 +1,86 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+)
+
+// SetMessageTTL implements service.ChatService.
+func (s *chatService) SetMessageTTL(ctx context.Context, chatID string, ttl time.Duration) error {
+	if ttl < 0 {
+		return errors.New("message ttl must not be negative")
+	}
+
+	if _, _, err := s.checkRole(ctx, chatID, opUpdateSettings); err != nil {
+		return err
+	}
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.chatRepository.SetMessageTTL(ctx, chatID, ttl)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.logRepository.Log(ctx, &model.Log{
+			Action:  model.ActionChatSetTTL,
+			ChatID:  chatID,
+			Payload: map[string]any{"ttl": ttl.String()},
+			Text:    fmt.Sprintf("Set message ttl %v for chat with id: %v", ttl, chatID),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return errors.New("chat not found")
+	}
+	if err != nil {
+		log.Print(err)
+		return errors.New("failed to set message ttl")
+	}
+
+	s.chatCache.invalidate(chatID)
+
+	return nil
+}
+
+// DeleteExpiredMessages implements service.ChatService.
+func (s *chatService) DeleteExpiredMessages(ctx context.Context, limit uint64) (int, error) {
+	messages, err := s.messagesRepository.DeleteExpired(ctx, limit)
+	if err != nil {
+		log.Print(err)
+		return 0, errors.New("failed to delete expired messages")
+	}
+
+	chats := make(map[string]int)
+	for _, msg := range messages {
+		chats[msg.ChatID]++
+		s.publish(msg.ChatID, &model.Message{
+			ID:     msg.ID,
+			ChatID: msg.ChatID,
+			Event:  model.EventDeleted,
+		})
+	}
+
+	if len(messages) > 0 {
+		err = s.logRepository.Log(ctx, &model.Log{
+			Action:  model.ActionMessageExpire,
+			Payload: map[string]any{"deleted": len(messages), "chats": chats},
+			Text:    fmt.Sprintf("Deleted %d expired messages in %d chats", len(messages), len(chats)),
+		})
+		if err != nil {
+			// Messages are already deleted, the run is only missing from the audit log
+			log.Print(err)
+		}
+	}
+
+	return len(messages), nil
+}
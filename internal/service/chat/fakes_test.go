@@ -0,0 +1,496 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/filter"
+	"github.com/8thgencore/microservice-chat/internal/identity"
+	"github.com/8thgencore/microservice-chat/internal/metrics"
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"github.com/8thgencore/microservice-common/pkg/db"
+)
+
+func TestMain(m *testing.M) {
+	if err := metrics.Init(context.Background()); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+// fakeDB keeps the rows of the fake repositories. Transactions restore a snapshot on failure,
+// so tests see rolled back writes disappear like they do in the database.
+type fakeDB struct {
+	chats        map[string]*model.Chat
+	members      map[string][]*model.Member
+	restrictions []*model.Restriction
+	messages     []*model.Message
+	scheduled    []*model.ScheduledMessage
+	logs         []*model.Log
+
+	// fail makes the named repository method return an error.
+	fail map[string]error
+	// inTx reports whether a transaction is open.
+	inTx bool
+	seq  int
+}
+
+func newFakeDB() *fakeDB {
+	return &fakeDB{
+		chats:   make(map[string]*model.Chat),
+		members: make(map[string][]*model.Member),
+		fail:    make(map[string]error),
+	}
+}
+
+func (f *fakeDB) nextID(prefix string) string {
+	f.seq++
+	return fmt.Sprintf("%s-%d", prefix, f.seq)
+}
+
+func (f *fakeDB) failure(method string) error {
+	return f.fail[method]
+}
+
+// addChat stores the chat with the members, the first one is the owner.
+func (f *fakeDB) addChat(id string, usernames ...string) {
+	f.chats[id] = &model.Chat{ID: id, Usernames: usernames}
+	for i, u := range usernames {
+		role := model.ChatRoleMember
+		if i == 0 {
+			role = model.ChatRoleOwner
+		}
+		f.members[id] = append(f.members[id], &model.Member{ChatID: id, Username: u, Role: role})
+	}
+}
+
+type fakeSnapshot struct {
+	chats        map[string]*model.Chat
+	members      map[string][]*model.Member
+	restrictions []*model.Restriction
+	messages     []*model.Message
+	scheduled    []*model.ScheduledMessage
+	logs         []*model.Log
+}
+
+func (f *fakeDB) snapshot() fakeSnapshot {
+	s := fakeSnapshot{
+		chats:        make(map[string]*model.Chat, len(f.chats)),
+		members:      make(map[string][]*model.Member, len(f.members)),
+		restrictions: slices.Clone(f.restrictions),
+	}
+	for id, c := range f.chats {
+		chat := *c
+		chat.Usernames = slices.Clone(c.Usernames)
+		s.chats[id] = &chat
+	}
+	for id, members := range f.members {
+		for _, m := range members {
+			member := *m
+			s.members[id] = append(s.members[id], &member)
+		}
+	}
+	for _, m := range f.messages {
+		msg := *m
+		s.messages = append(s.messages, &msg)
+	}
+	for _, m := range f.scheduled {
+		scheduled := *m
+		s.scheduled = append(s.scheduled, &scheduled)
+	}
+	for _, l := range f.logs {
+		record := *l
+		s.logs = append(s.logs, &record)
+	}
+
+	return s
+}
+
+func (f *fakeDB) restore(s fakeSnapshot) {
+	f.chats = s.chats
+	f.members = s.members
+	f.restrictions = s.restrictions
+	f.messages = s.messages
+	f.scheduled = s.scheduled
+	f.logs = s.logs
+}
+
+// ReadCommitted implements db.TxManager, nested calls join the open transaction.
+func (f *fakeDB) ReadCommitted(ctx context.Context, fn db.Handler) error {
+	if f.inTx {
+		return fn(ctx)
+	}
+
+	f.inTx = true
+	defer func() { f.inTx = false }()
+
+	s := f.snapshot()
+	if err := fn(ctx); err != nil {
+		f.restore(s)
+		return err
+	}
+
+	return nil
+}
+
+type fakeChatRepository struct {
+	repository.ChatRepository
+	db *fakeDB
+}
+
+func (r *fakeChatRepository) Get(_ context.Context, id string) (*model.Chat, error) {
+	chat, ok := r.db.chats[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+
+	return chat, nil
+}
+
+func (r *fakeChatRepository) RemoveUser(_ context.Context, username string) error {
+	for _, c := range r.db.chats {
+		c.Usernames = slices.DeleteFunc(c.Usernames, func(u string) bool { return u == username })
+	}
+
+	return nil
+}
+
+type fakeMembersRepository struct {
+	repository.MembersRepository
+	db *fakeDB
+}
+
+func (r *fakeMembersRepository) List(_ context.Context, chatID string) ([]*model.Member, error) {
+	return r.db.members[chatID], nil
+}
+
+func (r *fakeMembersRepository) ListByUser(_ context.Context, username string) ([]*model.Member, error) {
+	var res []*model.Member
+	for _, id := range slices.Sorted(maps.Keys(r.db.members)) {
+		for _, m := range r.db.members[id] {
+			if m.Username == username {
+				res = append(res, m)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (r *fakeMembersRepository) SetRole(_ context.Context, chatID string, username string, role model.ChatRole) error {
+	for _, m := range r.db.members[chatID] {
+		if m.Username == username {
+			m.Role = role
+			return nil
+		}
+	}
+
+	return repository.ErrNotFound
+}
+
+func (r *fakeMembersRepository) DeleteUser(_ context.Context, username string) ([]string, error) {
+	var ids []string
+	for _, id := range slices.Sorted(maps.Keys(r.db.members)) {
+		members := r.db.members[id]
+		kept := slices.DeleteFunc(slices.Clone(members), func(m *model.Member) bool { return m.Username == username })
+		if len(kept) != len(members) {
+			r.db.members[id] = kept
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
+
+type fakeRestrictionsRepository struct {
+	repository.RestrictionsRepository
+	db *fakeDB
+}
+
+func (r *fakeRestrictionsRepository) Upsert(_ context.Context, restriction *model.Restriction) error {
+	r.db.restrictions = slices.DeleteFunc(r.db.restrictions, func(x *model.Restriction) bool {
+		return x.ChatID == restriction.ChatID && x.Username == restriction.Username && x.Kind == restriction.Kind
+	})
+	r.db.restrictions = append(r.db.restrictions, restriction)
+
+	return nil
+}
+
+func (r *fakeRestrictionsRepository) List(
+	_ context.Context,
+	chatID string,
+	username string,
+) ([]*model.Restriction, error) {
+	var res []*model.Restriction
+	for _, x := range r.db.restrictions {
+		if x.ChatID == chatID && x.Username == username && x.Active(time.Now()) {
+			res = append(res, x)
+		}
+	}
+
+	return res, nil
+}
+
+func (r *fakeRestrictionsRepository) Delete(
+	_ context.Context,
+	chatID string,
+	username string,
+	kind model.RestrictionKind,
+) error {
+	n := len(r.db.restrictions)
+	r.db.restrictions = slices.DeleteFunc(r.db.restrictions, func(x *model.Restriction) bool {
+		return x.ChatID == chatID && x.Username == username && x.Kind == kind
+	})
+	if len(r.db.restrictions) == n {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *fakeRestrictionsRepository) DeleteUser(_ context.Context, username string) error {
+	r.db.restrictions = slices.DeleteFunc(r.db.restrictions, func(x *model.Restriction) bool {
+		return x.Username == username
+	})
+
+	return nil
+}
+
+type fakeMessagesRepository struct {
+	repository.MessagesRepository
+	db *fakeDB
+}
+
+func (r *fakeMessagesRepository) Create(_ context.Context, chatID string, message *model.Message) (string, error) {
+	if err := r.db.failure("messages.Create"); err != nil {
+		return "", err
+	}
+
+	if message.ClientMessageID != "" {
+		_, err := r.GetByClientMessageID(context.Background(), chatID, message.From, message.ClientMessageID)
+		if err == nil {
+			return "", repository.ErrAlreadyExists
+		}
+	}
+
+	stored := *message
+	stored.ID = r.db.nextID("message")
+	stored.ChatID = chatID
+	r.db.messages = append(r.db.messages, &stored)
+
+	return stored.ID, nil
+}
+
+func (r *fakeMessagesRepository) GetByClientMessageID(
+	_ context.Context,
+	chatID string,
+	from string,
+	clientMessageID string,
+) (*model.Message, error) {
+	for _, m := range r.db.messages {
+		if m.ChatID == chatID && m.From == from && m.ClientMessageID == clientMessageID {
+			stored := *m
+			return &stored, nil
+		}
+	}
+
+	return nil, repository.ErrNotFound
+}
+
+func (r *fakeMessagesRepository) ListByAuthor(
+	_ context.Context,
+	username string,
+	after *model.Message,
+	limit uint64,
+) ([]*model.Message, error) {
+	var res []*model.Message
+	found := after == nil
+	for _, m := range r.db.messages {
+		if !found {
+			found = m.ID == after.ID
+			continue
+		}
+		expired := !m.ExpiresAt.IsZero() && !m.ExpiresAt.After(time.Now())
+		if m.From == username && !expired && uint64(len(res)) < limit {
+			res = append(res, m)
+		}
+	}
+
+	return res, nil
+}
+
+func (r *fakeMessagesRepository) AnonymizeAuthor(_ context.Context, username string, pseudonym string) (int, error) {
+	n := 0
+	for _, m := range r.db.messages {
+		if m.From == username {
+			m.From = pseudonym
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+type fakeScheduledRepository struct {
+	repository.ScheduledRepository
+	db *fakeDB
+}
+
+func (r *fakeScheduledRepository) Create(_ context.Context, scheduled *model.ScheduledMessage) (string, error) {
+	stored := *scheduled
+	stored.ID = r.db.nextID("scheduled")
+	stored.Status = model.ScheduledPending
+	r.db.scheduled = append(r.db.scheduled, &stored)
+
+	return stored.ID, nil
+}
+
+func (r *fakeScheduledRepository) ClaimDue(_ context.Context, limit uint64) ([]*model.ScheduledMessage, error) {
+	var res []*model.ScheduledMessage
+	for _, m := range r.db.scheduled {
+		if m.Status == model.ScheduledPending && !m.SendAt.After(time.Now()) && uint64(len(res)) < limit {
+			res = append(res, m)
+		}
+	}
+
+	return res, nil
+}
+
+func (r *fakeScheduledRepository) MarkSent(_ context.Context, id string) error {
+	if err := r.db.failure("scheduled.MarkSent"); err != nil {
+		return err
+	}
+
+	for _, m := range r.db.scheduled {
+		if m.ID == id {
+			m.Status = model.ScheduledSent
+		}
+	}
+
+	return nil
+}
+
+func (r *fakeScheduledRepository) DeleteByAuthor(_ context.Context, username string) error {
+	r.db.scheduled = slices.DeleteFunc(r.db.scheduled, func(m *model.ScheduledMessage) bool {
+		return m.Message.From == username
+	})
+
+	return nil
+}
+
+type fakeLogRepository struct {
+	repository.LogRepository
+	db *fakeDB
+}
+
+func (r *fakeLogRepository) Log(ctx context.Context, log *model.Log) error {
+	stored := *log
+	if stored.Actor == "" {
+		if principal, ok := identity.FromContext(ctx); ok {
+			stored.Actor = principal.Username
+		}
+	}
+	r.db.logs = append(r.db.logs, &stored)
+
+	return nil
+}
+
+func (r *fakeLogRepository) AnonymizeUser(_ context.Context, username string, pseudonym string) error {
+	for _, l := range r.db.logs {
+		l.Anonymize(username, pseudonym)
+	}
+
+	return nil
+}
+
+// noopRepositories stand in for repositories whose data a test does not look at.
+type noopReportsRepository struct{ repository.ReportsRepository }
+
+func (noopReportsRepository) AnonymizeUser(context.Context, string, string) error { return nil }
+
+type noopPayloadsRepository struct{ repository.PayloadsRepository }
+
+func (noopPayloadsRepository) DeleteRecipient(context.Context, string) error { return nil }
+
+type noopBlocksRepository struct{ repository.BlocksRepository }
+
+func (noopBlocksRepository) List(context.Context, string) ([]*model.Block, error) { return nil, nil }
+func (noopBlocksRepository) DeleteUser(context.Context, string) error             { return nil }
+
+type noopDevicesRepository struct{ repository.DevicesRepository }
+
+func (noopDevicesRepository) List(context.Context, string) ([]*model.DeviceKey, error) {
+	return nil, nil
+}
+func (noopDevicesRepository) DeleteUser(context.Context, string) error { return nil }
+
+// fakeStream is a connected stream which only has a context.
+type fakeStream struct {
+	model.Stream
+	ctx context.Context
+}
+
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
+
+// newTestService creates the chat service on top of the fake database.
+func newTestService(f *fakeDB) *chatService {
+	return NewService(
+		&fakeChatRepository{db: f},
+		&fakeMembersRepository{db: f},
+		&fakeRestrictionsRepository{db: f},
+		&fakeMessagesRepository{db: f},
+		&fakeLogRepository{db: f},
+		&fakeScheduledRepository{db: f},
+		nil,
+		noopReportsRepository{},
+		noopBlocksRepository{},
+		noopPayloadsRepository{},
+		noopDevicesRepository{},
+		f,
+		filter.Chain{},
+		nil,
+		time.Minute,
+	).(*chatService)
+}
+
+// asUser returns the context of a request authenticated as the user.
+func asUser(username string, role string) context.Context {
+	return identity.WithPrincipal(context.Background(), &model.Principal{Username: username, Role: role})
+}
+
+// listen registers a listener of the chat and returns the channel its broadcasts go to.
+func listen(t *testing.T, s *chatService, chatID string) chan *model.Message {
+	t.Helper()
+
+	s.mxChat.Lock()
+	s.chats[chatID] = &chat{
+		streams:        map[string]model.Stream{"listener": nil},
+		kicks:          make(map[string]chan struct{}),
+		includeBlocked: make(map[string]bool),
+	}
+	s.mxChat.Unlock()
+
+	return s.channel(chatID)
+}
+
+// broadcasts drains the messages published to the channel.
+func broadcasts(ch chan *model.Message) []*model.Message {
+	var res []*model.Message
+	for {
+		select {
+		case m := <-ch:
+			res = append(res, m)
+		default:
+			return res
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/8thgencore/microservice-chat/internal/identity"
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxImportBatchSize = 1000
+
+// ImportMessages implements service.ChatService.
+func (s *chatService) ImportMessages(ctx context.Context, chatID string, messages []*model.Message) (int, int, error) {
+	if principal, _ := identity.FromContext(ctx); !principal.IsAdmin() {
+		return 0, 0, status.Error(codes.PermissionDenied, "only administrators can import messages")
+	}
+
+	if len(messages) == 0 {
+		return 0, 0, nil
+	}
+	if len(messages) > maxImportBatchSize {
+		return 0, 0, fmt.Errorf("batch must not contain more than %d messages", maxImportBatchSize)
+	}
+	for n, msg := range messages {
+		if msg.From == "" {
+			return 0, 0, invalidField(fmt.Sprintf("messages[%d].from", n), fmt.Sprintf("message %d has no author", n))
+		}
+		if msg.Timestamp.Unix() <= 0 {
+			return 0, 0, invalidField(fmt.Sprintf("messages[%d].timestamp", n), fmt.Sprintf("message %d has no timestamp", n))
+		}
+	}
+
+	item, err := s.getChat(ctx, chatID)
+	if err != nil {
+		return 0, 0, err
+	}
+	if item.chat.E2E {
+		return 0, 0, plaintextRequired("importing plain text history")
+	}
+
+	var imported int
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		imported, errTx = s.messagesRepository.CreateBatch(ctx, chatID, messages)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.logRepository.Log(ctx, &model.Log{
+			Action:  model.ActionMessageImport,
+			ChatID:  chatID,
+			Payload: map[string]any{"imported": imported},
+			Text:    fmt.Sprintf("Imported %d messages into chat with id: %v", imported, chatID),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Print(err)
+		return 0, 0, errors.New("failed to import messages")
+	}
+
+	return imported, len(messages) - imported, nil
+}
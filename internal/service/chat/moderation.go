@@ -0,0 +1,193 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/identity"
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Audit actions of applying and lifting each restriction kind.
+var (
+	restrictAction = map[model.RestrictionKind]string{
+		model.RestrictionBan:  model.ActionMemberBan,
+		model.RestrictionMute: model.ActionMemberMute,
+	}
+	unrestrictAction = map[model.RestrictionKind]string{
+		model.RestrictionBan:  model.ActionMemberUnban,
+		model.RestrictionMute: model.ActionMemberUnmute,
+	}
+)
+
+// BanUser implements service.ChatService.
+func (s *chatService) BanUser(ctx context.Context, chatID string, username string, duration time.Duration) error {
+	if err := s.restrict(ctx, chatID, username, model.RestrictionBan, duration); err != nil {
+		return err
+	}
+
+	// Streams on other replicas end on their next ban check
+	s.disconnect(chatID, username)
+
+	return nil
+}
+
+// UnbanUser implements service.ChatService.
+func (s *chatService) UnbanUser(ctx context.Context, chatID string, username string) error {
+	return s.unrestrict(ctx, chatID, username, model.RestrictionBan)
+}
+
+// MuteUser implements service.ChatService.
+func (s *chatService) MuteUser(ctx context.Context, chatID string, username string, duration time.Duration) error {
+	return s.restrict(ctx, chatID, username, model.RestrictionMute, duration)
+}
+
+// UnmuteUser implements service.ChatService.
+func (s *chatService) UnmuteUser(ctx context.Context, chatID string, username string) error {
+	return s.unrestrict(ctx, chatID, username, model.RestrictionMute)
+}
+
+func (s *chatService) restrict(
+	ctx context.Context,
+	chatID string,
+	username string,
+	kind model.RestrictionKind,
+	duration time.Duration,
+) error {
+	if duration < 0 {
+		return status.Error(codes.InvalidArgument, "duration must not be negative")
+	}
+
+	caller, item, err := s.checkRole(ctx, chatID, opRestrictMember)
+	if err != nil {
+		return err
+	}
+	if err = checkOutranks(ctx, item, caller, username); err != nil {
+		return err
+	}
+
+	restriction := &model.Restriction{
+		ChatID:    chatID,
+		Username:  username,
+		Kind:      kind,
+		CreatedBy: caller,
+	}
+	expires := "permanently"
+	if duration > 0 {
+		expiresAt := time.Now().Add(duration)
+		restriction.ExpiresAt = &expiresAt
+		expires = fmt.Sprintf("until %v", expiresAt.UTC().Format(time.RFC3339))
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.restrictionsRepository.Upsert(ctx, restriction)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.logRepository.Log(ctx, &model.Log{
+			Action:  restrictAction[kind],
+			ChatID:  chatID,
+			Payload: map[string]any{"username": username, "expires_at": restriction.ExpiresAt},
+			Text: fmt.Sprintf(
+				"Applied %v to %v %v by %v in chat with id: %v", kind, username, expires, caller, chatID,
+			),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Print(err)
+		return fmt.Errorf("failed to %s user", kind)
+	}
+
+	return nil
+}
+
+func (s *chatService) unrestrict(ctx context.Context, chatID string, username string, kind model.RestrictionKind) error {
+	caller, _, err := s.checkRole(ctx, chatID, opRestrictMember)
+	if err != nil {
+		return err
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.restrictionsRepository.Delete(ctx, chatID, username, kind)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.logRepository.Log(ctx, &model.Log{
+			Action:  unrestrictAction[kind],
+			ChatID:  chatID,
+			Payload: map[string]any{"username": username},
+			Text:    fmt.Sprintf("Lifted %v of %v by %v in chat with id: %v", kind, username, caller, chatID),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return status.Errorf(codes.NotFound, "user is not under %s", kind)
+	}
+	if err != nil {
+		log.Print(err)
+		return fmt.Errorf("failed to lift %s", kind)
+	}
+
+	return nil
+}
+
+// checkOutranks makes sure the caller's chat role is above the target's one.
+// Users who are not members can be restricted in advance, so they can not join later.
+func checkOutranks(ctx context.Context, item *cachedChat, caller string, target string) error {
+	if caller == target {
+		return status.Error(codes.InvalidArgument, "caller can not restrict themselves")
+	}
+
+	targetRole, ok := item.role(target)
+	if !ok {
+		return nil
+	}
+	if principal, _ := identity.FromContext(ctx); principal.IsAdmin() {
+		return nil
+	}
+
+	callerRole, _ := item.role(caller)
+	if targetRole.AtLeast(callerRole) {
+		return status.Error(codes.PermissionDenied, "member with the same or higher role can not be restricted")
+	}
+
+	return nil
+}
+
+// disconnect ends the stream of the user connected to the chat on this replica.
+func (s *chatService) disconnect(chatID string, username string) {
+	s.mxChat.RLock()
+	c, ok := s.chats[chatID]
+	s.mxChat.RUnlock()
+
+	if !ok {
+		return
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	delete(c.streams, username)
+	delete(c.includeBlocked, username)
+	if kick, okKick := c.kicks[username]; okKick {
+		close(kick)
+		delete(c.kicks, username)
+	}
+}
@@ -0,0 +1,152 @@
+package chat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRestrictionsApplyAcrossReplicas(t *testing.T) {
+	tests := []struct {
+		name string
+		// moderate runs on the other replica
+		moderate   func(s *chatService) error
+		wantRead   codes.Code
+		wantSend   codes.Code
+		wantKicked bool
+	}{
+		{
+			name:     "no restriction",
+			moderate: func(*chatService) error { return nil },
+			wantRead: codes.OK,
+			wantSend: codes.OK,
+		},
+		{
+			name: "ban",
+			moderate: func(s *chatService) error {
+				return s.BanUser(asUser("alice", ""), "chat", "bob", 0)
+			},
+			wantRead:   codes.PermissionDenied,
+			wantSend:   codes.PermissionDenied,
+			wantKicked: true,
+		},
+		{
+			name: "mute",
+			moderate: func(s *chatService) error {
+				return s.MuteUser(asUser("alice", ""), "chat", "bob", time.Hour)
+			},
+			wantRead: codes.OK,
+			wantSend: codes.PermissionDenied,
+		},
+		{
+			name: "lifted ban",
+			moderate: func(s *chatService) error {
+				if err := s.BanUser(asUser("alice", ""), "chat", "bob", 0); err != nil {
+					return err
+				}
+				return s.UnbanUser(asUser("alice", ""), "chat", "bob")
+			},
+			wantRead: codes.OK,
+			wantSend: codes.OK,
+		},
+		{
+			name: "expired ban",
+			moderate: func(s *chatService) error {
+				return s.BanUser(asUser("alice", ""), "chat", "bob", time.Nanosecond)
+			},
+			wantRead: codes.OK,
+			wantSend: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFakeDB()
+			f.addChat("chat", "alice", "bob")
+			local, other := newTestService(f), newTestService(f)
+
+			// The chat is cached on this replica before the restriction is made on the other one
+			if _, _, err := local.checkSender(asUser("bob", ""), "chat", ""); err != nil {
+				t.Fatal(err)
+			}
+			if err := tt.moderate(other); err != nil {
+				t.Fatal(err)
+			}
+			time.Sleep(time.Millisecond)
+
+			_, _, err := local.checkReader(asUser("bob", ""), "chat", "")
+			if code := status.Code(err); code != tt.wantRead {
+				t.Errorf("read: code = %v, want %v", code, tt.wantRead)
+			}
+			_, _, err = local.checkSender(asUser("bob", ""), "chat", "")
+			if code := status.Code(err); code != tt.wantSend {
+				t.Errorf("send: code = %v, want %v", code, tt.wantSend)
+			}
+
+			banned, err := local.restricted(asUser("bob", ""), "chat", "bob", model.RestrictionBan)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if banned != tt.wantKicked {
+				t.Errorf("stream ban check = %v, want %v", banned, tt.wantKicked)
+			}
+		})
+	}
+}
+
+func TestRolesApplyAcrossReplicas(t *testing.T) {
+	tests := []struct {
+		name string
+		// change runs on the other replica, alice owns the chat and bob is an admin
+		change   func(s *chatService) error
+		wantCode codes.Code
+	}{
+		{
+			name:     "admin",
+			change:   func(*chatService) error { return nil },
+			wantCode: codes.OK,
+		},
+		{
+			name: "demoted admin",
+			change: func(s *chatService) error {
+				return s.DemoteMember(asUser("alice", ""), "chat", "bob")
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "promoted member",
+			change: func(s *chatService) error {
+				if err := s.DemoteMember(asUser("alice", ""), "chat", "bob"); err != nil {
+					return err
+				}
+				return s.PromoteMember(asUser("alice", ""), "chat", "bob")
+			},
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFakeDB()
+			f.addChat("chat", "alice", "bob", "carol")
+			f.members["chat"][1].Role = model.ChatRoleAdmin
+			local, other := newTestService(f), newTestService(f)
+
+			// The chat is cached on this replica before the role is changed on the other one
+			if _, _, err := local.checkRole(asUser("bob", ""), "chat", opRestrictMember); err != nil {
+				t.Fatal(err)
+			}
+			if err := tt.change(other); err != nil {
+				t.Fatal(err)
+			}
+
+			err := local.MuteUser(asUser("bob", ""), "chat", "carol", time.Hour)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("code = %v, want %v", code, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package chat
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/identity"
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportBatchSize is the number of messages loaded at once while exporting user data.
+const exportBatchSize = 500
+
+// ExportUserData implements service.ChatService.
+func (s *chatService) ExportUserData(
+	ctx context.Context,
+	username string,
+	send func(*model.ExportRecord) error,
+) error {
+	if err := checkPrivacyRequest(ctx, username); err != nil {
+		return err
+	}
+
+	// The export itself is personal data processing, so it is audited before anything is sent
+	err := s.logRepository.Log(ctx, &model.Log{
+		Action:  model.ActionUserExport,
+		Payload: map[string]any{"username": username},
+		Text:    fmt.Sprintf("Exported data of user %v", username),
+	})
+	if err != nil {
+		log.Print(err)
+		return errors.New("failed to export user data")
+	}
+
+	err = send(&model.ExportRecord{Kind: model.ExportUser, Username: username, ExportedAt: time.Now()})
+	if err != nil {
+		return err
+	}
+
+	memberships, err := s.membersRepository.ListByUser(ctx, username)
+	if err != nil {
+		log.Print(err)
+		return errors.New("failed to export user data")
+	}
+	for _, m := range memberships {
+		if err = send(&model.ExportRecord{Kind: model.ExportMembership, Membership: m}); err != nil {
+			return err
+		}
+	}
+
+	var after *model.Message
+	for {
+		messages, errList := s.messagesRepository.ListByAuthor(ctx, username, after, exportBatchSize)
+		if errList != nil {
+			log.Print(errList)
+			return errors.New("failed to export user data")
+		}
+
+		for _, m := range messages {
+			if err = send(&model.ExportRecord{Kind: model.ExportMessage, Message: m}); err != nil {
+				return err
+			}
+		}
+
+		if len(messages) < exportBatchSize {
+			break
+		}
+		after = messages[len(messages)-1]
+	}
+
+	blocks, err := s.blocksRepository.List(ctx, username)
+	if err != nil {
+		log.Print(err)
+		return errors.New("failed to export user data")
+	}
+	for _, b := range blocks {
+		if err = send(&model.ExportRecord{Kind: model.ExportBlock, Block: b}); err != nil {
+			return err
+		}
+	}
+
+	keys, err := s.devicesRepository.List(ctx, username)
+	if err != nil {
+		log.Print(err)
+		return errors.New("failed to export user data")
+	}
+	for _, k := range keys {
+		if err = send(&model.ExportRecord{Kind: model.ExportDeviceKey, DeviceKey: k}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EraseUser implements service.ChatService.
+// Restrictions, blocks, device keys, scheduled messages and payloads addressed to the user are deleted.
+// Audit log records are kept as they are needed to account for past actions, they name the user by the pseudonym.
+// The oldest admin, or the oldest member if there is none, becomes the owner of the chats the user owned.
+func (s *chatService) EraseUser(ctx context.Context, username string) (*model.Erasure, error) {
+	if err := checkPrivacyRequest(ctx, username); err != nil {
+		return nil, err
+	}
+
+	pseudonym, err := newPseudonym()
+	if err != nil {
+		log.Print(err)
+		return nil, errors.New("failed to erase user")
+	}
+
+	erasure := &model.Erasure{Username: username, Pseudonym: pseudonym}
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		memberships, errTx := s.membersRepository.ListByUser(ctx, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.chatRepository.RemoveUser(ctx, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		erasure.ChatIDs, errTx = s.membersRepository.DeleteUser(ctx, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		for _, m := range memberships {
+			if m.Role != model.ChatRoleOwner {
+				continue
+			}
+			if errTx = s.promoteSuccessor(ctx, m.ChatID); errTx != nil {
+				return errTx
+			}
+		}
+
+		errTx = s.restrictionsRepository.DeleteUser(ctx, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		erasure.Messages, errTx = s.messagesRepository.AnonymizeAuthor(ctx, username, pseudonym)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.reportsRepository.AnonymizeUser(ctx, username, pseudonym)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.payloadsRepository.DeleteRecipient(ctx, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.scheduledRepository.DeleteByAuthor(ctx, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.blocksRepository.DeleteUser(ctx, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.devicesRepository.DeleteUser(ctx, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.logRepository.AnonymizeUser(ctx, username, pseudonym)
+		if errTx != nil {
+			return errTx
+		}
+
+		// The record must not link the username to the pseudonym, otherwise the erasure could be undone
+		errTx = s.logRepository.Log(ctx, &model.Log{
+			Action: model.ActionUserErase,
+			Payload: map[string]any{
+				"pseudonym": pseudonym,
+				"chat_ids":  erasure.ChatIDs,
+				"messages":  erasure.Messages,
+			},
+			Text: fmt.Sprintf("Erased user as %v from %d chats", pseudonym, len(erasure.ChatIDs)),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Print(err)
+		return nil, errors.New("failed to erase user")
+	}
+
+	for _, chatID := range erasure.ChatIDs {
+		s.chatCache.invalidate(chatID)
+		s.disconnect(chatID, username)
+	}
+	s.blockCache.invalidate(username)
+
+	return erasure, nil
+}
+
+// promoteSuccessor makes the oldest admin of the chat its owner, or the oldest member if there is no admin.
+// A chat left without members stays without an owner.
+func (s *chatService) promoteSuccessor(ctx context.Context, chatID string) error {
+	members, err := s.membersRepository.List(ctx, chatID)
+	if err != nil {
+		return err
+	}
+
+	var successor *model.Member
+	for _, m := range members {
+		if m.Role == model.ChatRoleAdmin {
+			successor = m
+			break
+		}
+		if successor == nil {
+			successor = m
+		}
+	}
+	if successor == nil {
+		return nil
+	}
+
+	return s.setRoles(ctx, chatID, "failed to promote chat owner", &model.Member{
+		Username: successor.Username,
+		Role:     model.ChatRoleOwner,
+	})
+}
+
+func checkPrivacyRequest(ctx context.Context, username string) error {
+	if principal, _ := identity.FromContext(ctx); !principal.IsAdmin() {
+		return status.Error(codes.PermissionDenied, "only administrators can handle user data requests")
+	}
+	if username == "" {
+		return status.Error(codes.InvalidArgument, "username must not be empty")
+	}
+
+	return nil
+}
+
+// newPseudonym returns a random name, so erased users can not be recovered from it.
+func newPseudonym() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return model.ErasedUsernamePrefix + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,228 @@
+package chat
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+)
+
+func TestEraseUserPromotesSuccessor(t *testing.T) {
+	tests := []struct {
+		name      string
+		usernames []string
+		admins    []string
+		erase     string
+		wantOwner string
+	}{
+		{
+			name:      "oldest admin",
+			usernames: []string{"alice", "bob", "carol", "dave"},
+			admins:    []string{"carol", "dave"},
+			erase:     "alice",
+			wantOwner: "carol",
+		},
+		{
+			name:      "oldest member without admins",
+			usernames: []string{"alice", "bob", "carol"},
+			erase:     "alice",
+			wantOwner: "bob",
+		},
+		{
+			name:      "last member",
+			usernames: []string{"alice"},
+			erase:     "alice",
+			wantOwner: "",
+		},
+		{
+			name:      "erased member is not the owner",
+			usernames: []string{"alice", "bob", "carol"},
+			admins:    []string{"carol"},
+			erase:     "bob",
+			wantOwner: "alice",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFakeDB()
+			f.addChat("chat", tt.usernames...)
+			for _, m := range f.members["chat"] {
+				for _, admin := range tt.admins {
+					if m.Username == admin {
+						m.Role = model.ChatRoleAdmin
+					}
+				}
+			}
+			s := newTestService(f)
+
+			if _, err := s.EraseUser(asUser("root", model.RoleAdmin), tt.erase); err != nil {
+				t.Fatal(err)
+			}
+
+			owner := ""
+			for _, m := range f.members["chat"] {
+				if m.Username == tt.erase {
+					t.Errorf("erased user is still a member")
+				}
+				if m.Role == model.ChatRoleOwner {
+					if owner != "" {
+						t.Errorf("chat has owners %v and %v", owner, m.Username)
+					}
+					owner = m.Username
+				}
+			}
+			if owner != tt.wantOwner {
+				t.Errorf("owner = %q, want %q", owner, tt.wantOwner)
+			}
+		})
+	}
+}
+
+func TestEraseUserAuditLog(t *testing.T) {
+	tests := []struct {
+		name        string
+		record      *model.Log
+		wantActor   string
+		wantText    string
+		wantPayload map[string]any
+	}{
+		{
+			name:      "action of the erased user",
+			record:    &model.Log{Actor: "alice", Action: model.ActionUserBlock, Text: "Blocked bob by alice"},
+			wantActor: "{pseudonym}",
+			wantText:  "Blocked bob by {pseudonym}",
+		},
+		{
+			name: "action done to the erased user",
+			record: &model.Log{
+				Actor:   "bob",
+				Action:  model.ActionUserBlock,
+				Payload: map[string]any{"username": "alice"},
+				Text:    "Blocked alice by bob",
+			},
+			wantActor:   "bob",
+			wantText:    "Blocked {pseudonym} by bob",
+			wantPayload: map[string]any{"username": "{pseudonym}"},
+		},
+		{
+			name: "erased user among others",
+			record: &model.Log{
+				Actor:   "bob",
+				Action:  model.ActionChatCreate,
+				Payload: map[string]any{"usernames": []any{"bob", "alice"}},
+				Text:    "Created chat with id: chat",
+			},
+			wantActor:   "bob",
+			wantText:    "Created chat with id: chat",
+			wantPayload: map[string]any{"usernames": []any{"bob", "{pseudonym}"}},
+		},
+		{
+			name: "longer names are kept",
+			record: &model.Log{
+				Actor:   "malice",
+				Action:  model.ActionMemberSetRole,
+				Payload: map[string]any{"username": "alice2"},
+				Text:    "Set role admin for alice2 in chat with id: alice-chat",
+			},
+			wantActor:   "malice",
+			wantText:    "Set role admin for alice2 in chat with id: alice-chat",
+			wantPayload: map[string]any{"username": "alice2"},
+		},
+		{
+			name:      "action of another user",
+			record:    &model.Log{Actor: "bob", Action: model.ActionMessageReport, Text: "Reported message"},
+			wantActor: "bob",
+			wantText:  "Reported message",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFakeDB()
+			f.addChat("chat", "alice", "bob")
+			f.logs = append(f.logs, tt.record)
+			s := newTestService(f)
+
+			erasure, err := s.EraseUser(asUser("root", model.RoleAdmin), "alice")
+			if err != nil {
+				t.Fatal(err)
+			}
+			pseudonymize := func(s string) string {
+				return strings.ReplaceAll(s, "{pseudonym}", erasure.Pseudonym)
+			}
+
+			record := f.logs[0]
+			if want := pseudonymize(tt.wantActor); record.Actor != want {
+				t.Errorf("actor = %q, want %q", record.Actor, want)
+			}
+			if want := pseudonymize(tt.wantText); record.Text != want {
+				t.Errorf("text = %q, want %q", record.Text, want)
+			}
+			if want := fmt.Sprint(tt.wantPayload); fmt.Sprint(record.Payload) != pseudonymize(want) {
+				t.Errorf("payload = %v, want %v", record.Payload, pseudonymize(want))
+			}
+		})
+	}
+}
+
+func TestEraseUserAuditRecord(t *testing.T) {
+	f := newFakeDB()
+	f.addChat("chat", "alice", "bob")
+	s := newTestService(f)
+
+	if _, err := s.EraseUser(asUser("root", model.RoleAdmin), "alice"); err != nil {
+		t.Fatal(err)
+	}
+
+	var erase *model.Log
+	for _, l := range f.logs {
+		if l.Action == model.ActionUserErase {
+			erase = l
+		}
+	}
+	if erase == nil {
+		t.Fatal("erasure is not audited")
+	}
+	if erase.Actor != "root" {
+		t.Errorf("erasure actor = %q, want root", erase.Actor)
+	}
+	if strings.Contains(erase.Text, "alice") {
+		t.Errorf("erasure record text %q contains the username", erase.Text)
+	}
+	for key, value := range erase.Payload {
+		if value == "alice" {
+			t.Errorf("erasure record payload %q holds the username", key)
+		}
+	}
+}
+
+func TestExportUserDataSkipsExpiredMessages(t *testing.T) {
+	f := newFakeDB()
+	f.addChat("chat", "alice", "bob")
+	f.messages = []*model.Message{
+		{ID: "1", ChatID: "chat", From: "alice", Text: "kept"},
+		{ID: "2", ChatID: "chat", From: "alice", Text: "expired", ExpiresAt: time.Now().Add(-time.Minute)},
+		{ID: "3", ChatID: "chat", From: "alice", Text: "expiring", ExpiresAt: time.Now().Add(time.Hour)},
+		{ID: "4", ChatID: "chat", From: "bob", Text: "other"},
+	}
+	s := newTestService(f)
+
+	var texts []string
+	err := s.ExportUserData(asUser("root", model.RoleAdmin), "alice", func(r *model.ExportRecord) error {
+		if r.Kind == model.ExportMessage {
+			texts = append(texts, r.Message.Text)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ExportUserData() error = %v", err)
+	}
+
+	if want := []string{"kept", "expiring"}; !slices.Equal(texts, want) {
+		t.Errorf("exported messages = %v, want %v", texts, want)
+	}
+}
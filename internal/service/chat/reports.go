@@ -0,0 +1,225 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/pagination"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReportMessage implements service.ChatService.
+func (s *chatService) ReportMessage(ctx context.Context, messageID string, reason string) (string, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return "", status.Error(codes.InvalidArgument, "report reason must not be empty")
+	}
+
+	message, err := s.messagesRepository.Get(ctx, messageID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return "", status.Error(codes.NotFound, "message not found")
+	}
+	if err != nil {
+		log.Print(err)
+		return "", errors.New("failed to get message")
+	}
+
+	reporter, _, err := s.checkReader(ctx, message.ChatID, "")
+	if err != nil {
+		return "", err
+	}
+	if reporter == message.From {
+		return "", status.Error(codes.InvalidArgument, "caller can not report their own message")
+	}
+
+	report := &model.Report{
+		ChatID:      message.ChatID,
+		MessageID:   message.ID,
+		MessageFrom: message.From,
+		MessageText: message.Text,
+		Reporter:    reporter,
+		Reason:      reason,
+		Status:      model.ReportOpen,
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		report.ID, errTx = s.reportsRepository.Create(ctx, report)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.logRepository.Log(ctx, &model.Log{
+			Action:    model.ActionMessageReport,
+			ChatID:    message.ChatID,
+			MessageID: message.ID,
+			Payload:   map[string]any{"report_id": report.ID, "reason": reason},
+			Text: fmt.Sprintf(
+				"Reported message with id: %v by %v in chat with id: %v", message.ID, reporter, message.ChatID,
+			),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return "", status.Error(codes.AlreadyExists, "message is already reported by the caller")
+	}
+	if err != nil {
+		log.Print(err)
+		return "", errors.New("failed to report message")
+	}
+
+	s.publish(message.ChatID, &model.Message{
+		ID:     message.ID,
+		ChatID: message.ChatID,
+		Event:  model.EventReported,
+		Report: report,
+	})
+
+	return report.ID, nil
+}
+
+// ListReports implements service.ChatService.
+func (s *chatService) ListReports(
+	ctx context.Context,
+	filter model.ReportFilter,
+	pageToken string,
+) ([]*model.Report, string, error) {
+	if filter.Status == "" {
+		filter.Status = model.ReportOpen
+	}
+
+	if _, _, err := s.checkRole(ctx, filter.ChatID, opModerate); err != nil {
+		return nil, "", err
+	}
+
+	if pageToken != "" {
+		createdAt, id, err := pagination.DecodeCursor(pageToken)
+		if err != nil {
+			return nil, "", status.Error(codes.InvalidArgument, "invalid page token")
+		}
+		filter.After = &model.ReportCursor{CreatedAt: createdAt, ID: id}
+	}
+
+	// One more report tells whether there is a next page
+	pageSize := pagination.PageSize(filter.Limit)
+	filter.Limit = pageSize + 1
+
+	reports, err := s.reportsRepository.List(ctx, filter)
+	if err != nil {
+		log.Print(err)
+		return nil, "", errors.New("failed to list reports")
+	}
+
+	if uint64(len(reports)) <= pageSize {
+		return reports, "", nil
+	}
+
+	reports = reports[:pageSize]
+	last := reports[len(reports)-1]
+
+	return reports, pagination.EncodeCursor(last.CreatedAt, last.ID), nil
+}
+
+// ResolveReport implements service.ChatService.
+func (s *chatService) ResolveReport(ctx context.Context, id string, resolution model.ReportStatus) error {
+	switch resolution {
+	case model.ReportDismissed, model.ReportMessageDeleted, model.ReportAuthorBanned:
+	default:
+		return status.Errorf(codes.InvalidArgument, "unknown report resolution: %s", resolution)
+	}
+
+	report, err := s.reportsRepository.Get(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return status.Error(codes.NotFound, "report not found")
+	}
+	if err != nil {
+		log.Print(err)
+		return errors.New("failed to get report")
+	}
+
+	caller, item, err := s.checkRole(ctx, report.ChatID, opModerate)
+	if err != nil {
+		return err
+	}
+	if resolution == model.ReportAuthorBanned {
+		if err = checkOutranks(ctx, item, caller, report.MessageFrom); err != nil {
+			return err
+		}
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.reportsRepository.Resolve(ctx, id, resolution, caller)
+		if errTx != nil {
+			return errTx
+		}
+
+		switch resolution {
+		case model.ReportMessageDeleted:
+			// The message may have expired or been deleted after another report
+			errTx = s.messagesRepository.Delete(ctx, report.MessageID)
+			if errTx != nil && !errors.Is(errTx, repository.ErrNotFound) {
+				return errTx
+			}
+		case model.ReportAuthorBanned:
+			errTx = s.restrictionsRepository.Upsert(ctx, &model.Restriction{
+				ChatID:    report.ChatID,
+				Username:  report.MessageFrom,
+				Kind:      model.RestrictionBan,
+				CreatedBy: caller,
+			})
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		errTx = s.logRepository.Log(ctx, &model.Log{
+			Action:    model.ActionReportResolve,
+			ChatID:    report.ChatID,
+			MessageID: report.MessageID,
+			Payload:   map[string]any{"report_id": id, "resolution": resolution, "author": report.MessageFrom},
+			Text: fmt.Sprintf(
+				"Resolved report with id: %v as %v by %v in chat with id: %v", id, resolution, caller, report.ChatID,
+			),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return status.Error(codes.FailedPrecondition, "report is already resolved")
+	}
+	if err != nil {
+		log.Print(err)
+		return errors.New("failed to resolve report")
+	}
+
+	switch resolution {
+	case model.ReportMessageDeleted:
+		s.publish(report.ChatID, &model.Message{ID: report.MessageID, ChatID: report.ChatID, Event: model.EventDeleted})
+	case model.ReportAuthorBanned:
+		s.disconnect(report.ChatID, report.MessageFrom)
+	}
+
+	report.Status = resolution
+	report.ResolvedBy = caller
+	s.publish(report.ChatID, &model.Message{
+		ID:     report.MessageID,
+		ChatID: report.ChatID,
+		Event:  model.EventReportResolved,
+		Report: report,
+	})
+
+	return nil
+}
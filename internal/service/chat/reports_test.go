@@ -0,0 +1,134 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+)
+
+type fakeReportsRepository struct {
+	noopReportsRepository
+	reports []*model.Report
+}
+
+func (r *fakeReportsRepository) List(_ context.Context, filter model.ReportFilter) ([]*model.Report, error) {
+	var res []*model.Report
+	for _, report := range r.reports {
+		if report.ChatID != filter.ChatID || report.Status != filter.Status {
+			continue
+		}
+		if filter.After != nil && !report.CreatedAt.After(filter.After.CreatedAt) {
+			continue
+		}
+		if uint64(len(res)) < filter.Limit {
+			res = append(res, report)
+		}
+	}
+
+	return res, nil
+}
+
+func TestListReportsPages(t *testing.T) {
+	tests := []struct {
+		name      string
+		reports   int
+		pageSize  uint64
+		wantPages []int
+	}{
+		{name: "no reports", reports: 0, pageSize: 2, wantPages: []int{0}},
+		{name: "single page", reports: 2, pageSize: 2, wantPages: []int{2}},
+		{name: "last page is partial", reports: 5, pageSize: 2, wantPages: []int{2, 2, 1}},
+		{name: "default page size", reports: 3, pageSize: 0, wantPages: []int{3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFakeDB()
+			f.addChat("chat", "alice", "bob")
+			s := newTestService(f)
+
+			repo := &fakeReportsRepository{}
+			start := time.Now()
+			for i := range tt.reports {
+				repo.reports = append(repo.reports, &model.Report{
+					ID:        fmt.Sprintf("00000000-0000-0000-0000-%012d", i),
+					ChatID:    "chat",
+					Status:    model.ReportOpen,
+					CreatedAt: start.Add(time.Duration(i) * time.Second),
+				})
+			}
+			s.reportsRepository = repo
+
+			var pages []int
+			token := ""
+			for {
+				filter := model.ReportFilter{ChatID: "chat", Limit: tt.pageSize}
+				reports, next, err := s.ListReports(asUser("alice", ""), filter, token)
+				if err != nil {
+					t.Fatal(err)
+				}
+				pages = append(pages, len(reports))
+				if next == "" {
+					break
+				}
+				token = next
+			}
+
+			if fmt.Sprint(pages) != fmt.Sprint(tt.wantPages) {
+				t.Errorf("pages = %v, want %v", pages, tt.wantPages)
+			}
+		})
+	}
+}
+
+func TestListReportsRequiresModerator(t *testing.T) {
+	f := newFakeDB()
+	f.addChat("chat", "alice", "bob")
+	s := newTestService(f)
+	s.reportsRepository = &fakeReportsRepository{}
+
+	if _, _, err := s.ListReports(asUser("bob", ""), model.ReportFilter{ChatID: "chat"}, ""); err == nil {
+		t.Fatal("member listed reports")
+	}
+	if _, _, err := s.ListReports(asUser("alice", ""), model.ReportFilter{ChatID: "chat"}, "bad"); err == nil {
+		t.Fatal("invalid page token was accepted")
+	}
+}
+
+func TestReceivesReportEvents(t *testing.T) {
+	f := newFakeDB()
+	f.addChat("chat", "alice", "bob")
+	s := newTestService(f)
+
+	item, err := s.getChat(context.Background(), "chat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		item   *cachedChat
+		member string
+		role   string
+		want   bool
+	}{
+		{name: "owner", item: item, member: "alice", want: true},
+		{name: "member", item: item, member: "bob", want: false},
+		{name: "service administrator", item: item, member: "carol", role: model.RoleAdmin, want: true},
+		{name: "chat could not be loaded", item: nil, member: "alice", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &fakeStream{ctx: asUser(tt.member, tt.role)}
+			message := &model.Message{ChatID: "chat", Event: model.EventReported, Report: &model.Report{}}
+
+			recipients := &audience{chat: tt.item}
+			if got := recipients.receives(tt.member, stream, message); got != tt.want {
+				t.Errorf("receives = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+)
+
+// SetRetention implements service.ChatService.
+func (s *chatService) SetRetention(ctx context.Context, chatID string, retention time.Duration) error {
+	if retention < 0 && retention != model.RetentionForever {
+		return errors.New("retention must not be negative")
+	}
+
+	if _, _, err := s.checkRole(ctx, chatID, opUpdateSettings); err != nil {
+		return err
+	}
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.chatRepository.SetRetention(ctx, chatID, retention)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.logRepository.Log(ctx, &model.Log{
+			Action:  model.ActionChatSetRetention,
+			ChatID:  chatID,
+			Payload: map[string]any{"retention": retentionText(retention)},
+			Text:    fmt.Sprintf("Set retention %v for chat with id: %v", retentionText(retention), chatID),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return errors.New("chat not found")
+	}
+	if err != nil {
+		log.Print(err)
+		return errors.New("failed to set retention")
+	}
+
+	s.chatCache.invalidate(chatID)
+
+	return nil
+}
+
+// PurgeMessages implements service.ChatService.
+func (s *chatService) PurgeMessages(ctx context.Context, opts model.PurgeOptions) (*model.PurgeReport, error) {
+	report := &model.PurgeReport{
+		DryRun: opts.DryRun,
+		Chats:  make(map[string]int),
+	}
+
+	if opts.DryRun {
+		counts, err := s.messagesRepository.CountOutsideRetention(ctx, opts.DefaultRetention)
+		if err != nil {
+			log.Print(err)
+			return nil, errors.New("failed to count messages outside retention")
+		}
+
+		for chatID, count := range counts {
+			report.Chats[chatID] = count
+			report.Deleted += count
+		}
+	} else {
+		// Every batch is deleted in its own transaction to keep locks short.
+		for ctx.Err() == nil {
+			var deleted []*model.Message
+			err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+				var errTx error
+				deleted, errTx = s.messagesRepository.DeleteOutsideRetention(ctx, opts.DefaultRetention, opts.BatchSize)
+				return errTx
+			})
+			if err != nil {
+				log.Print(err)
+				return nil, errors.New("failed to purge messages")
+			}
+
+			for _, msg := range deleted {
+				report.Chats[msg.ChatID]++
+				s.publish(msg.ChatID, &model.Message{
+					ID:     msg.ID,
+					ChatID: msg.ChatID,
+					Event:  model.EventDeleted,
+				})
+			}
+			report.Deleted += len(deleted)
+
+			if uint64(len(deleted)) < opts.BatchSize {
+				break
+			}
+		}
+	}
+
+	err := s.logRepository.Log(ctx, &model.Log{
+		Action:  model.ActionChatPurge,
+		Payload: map[string]any{"dry_run": report.DryRun, "deleted": report.Deleted, "chats": report.Chats},
+		Text:    purgeLogText(report),
+	})
+	if err != nil {
+		log.Print(err)
+		return nil, errors.New("failed to log purge run")
+	}
+
+	return report, nil
+}
+
+func retentionText(retention time.Duration) string {
+	if retention == model.RetentionForever {
+		return "forever"
+	}
+
+	return retention.String()
+}
+
+func purgeLogText(report *model.PurgeReport) string {
+	if report.DryRun {
+		return fmt.Sprintf("Retention purge dry run: %d messages in %d chats would be deleted: %v",
+			report.Deleted, len(report.Chats), report.Chats)
+	}
+
+	return fmt.Sprintf("Retention purge: deleted %d messages in %d chats: %v",
+		report.Deleted, len(report.Chats), report.Chats)
+}
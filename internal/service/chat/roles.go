@@ -0,0 +1,109 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PromoteMember implements service.ChatService.
+func (s *chatService) PromoteMember(ctx context.Context, chatID string, username string) error {
+	_, item, err := s.checkRole(ctx, chatID, opPromoteMember)
+	if err != nil {
+		return err
+	}
+
+	role, ok := item.role(username)
+	if !ok {
+		return status.Error(codes.NotFound, "user is not a member of the chat")
+	}
+	if role != model.ChatRoleMember {
+		return nil
+	}
+
+	return s.setRoles(ctx, chatID, "failed to promote member", &model.Member{Username: username, Role: model.ChatRoleAdmin})
+}
+
+// DemoteMember implements service.ChatService.
+func (s *chatService) DemoteMember(ctx context.Context, chatID string, username string) error {
+	_, item, err := s.checkRole(ctx, chatID, opDemoteMember)
+	if err != nil {
+		return err
+	}
+
+	role, ok := item.role(username)
+	if !ok {
+		return status.Error(codes.NotFound, "user is not a member of the chat")
+	}
+	if role == model.ChatRoleOwner {
+		return status.Error(codes.FailedPrecondition, "owner can not be demoted, transfer ownership first")
+	}
+	if role == model.ChatRoleMember {
+		return nil
+	}
+
+	return s.setRoles(ctx, chatID, "failed to demote member", &model.Member{Username: username, Role: model.ChatRoleMember})
+}
+
+// TransferOwnership implements service.ChatService.
+func (s *chatService) TransferOwnership(ctx context.Context, chatID string, username string) error {
+	_, item, err := s.checkRole(ctx, chatID, opTransferOwnership)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := item.role(username); !ok {
+		return status.Error(codes.NotFound, "user is not a member of the chat")
+	}
+
+	owner := item.owner()
+	if owner == username {
+		return nil
+	}
+
+	// The previous owner stays in charge as admin. It is changed first, a chat has at most one owner.
+	var members []*model.Member
+	if owner != "" {
+		members = append(members, &model.Member{Username: owner, Role: model.ChatRoleAdmin})
+	}
+	members = append(members, &model.Member{Username: username, Role: model.ChatRoleOwner})
+
+	return s.setRoles(ctx, chatID, "failed to transfer ownership", members...)
+}
+
+// setRoles changes roles of the chat members in order within one transaction.
+func (s *chatService) setRoles(ctx context.Context, chatID string, failure string, members ...*model.Member) error {
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		for _, m := range members {
+			errTx := s.membersRepository.SetRole(ctx, chatID, m.Username, m.Role)
+			if errTx != nil {
+				return errTx
+			}
+
+			errTx = s.logRepository.Log(ctx, &model.Log{
+				Action:  model.ActionMemberSetRole,
+				ChatID:  chatID,
+				Payload: map[string]any{"username": m.Username, "role": m.Role},
+				Text:    fmt.Sprintf("Set role %v for %v in chat with id: %v", m.Role, m.Username, chatID),
+			})
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Print(err)
+		return errors.New(failure)
+	}
+
+	s.chatCache.invalidate(chatID)
+
+	return nil
+}
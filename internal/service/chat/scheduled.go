@@ -0,0 +1,198 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/identity"
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ScheduleMessage implements service.ChatService.
+func (s *chatService) ScheduleMessage(
+	ctx context.Context,
+	chatID string,
+	message *model.Message,
+	sendAt time.Time,
+) (string, error) {
+	if !sendAt.After(time.Now()) {
+		return "", errors.New("send time must be in the future")
+	}
+
+	from, chat, err := s.checkSender(ctx, chatID, message.From)
+	if err != nil {
+		return "", err
+	}
+	message.From = from
+
+	// Scheduled messages are kept as plain text until they are sent
+	if chat.E2E {
+		return "", plaintextRequired("scheduling messages")
+	}
+
+	// Rejected texts are refused right away instead of failing at the delivery time.
+	// The text is filtered again when it is sent, as the filters may change in between.
+	result := s.filter.Apply(message.Text)
+	if result.Rejected {
+		return "", status.Errorf(codes.InvalidArgument, "message is rejected: %s", result.Reason)
+	}
+	message.Text = result.Text
+
+	// Spam is detected when the message is scheduled rather than in the delivery transaction,
+	// where a mute would be rolled back together with the refused message
+	if err = s.checkSpam(ctx, chatID, from, message.Text); err != nil {
+		return "", err
+	}
+
+	var id string
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		id, errTx = s.scheduledRepository.Create(ctx, &model.ScheduledMessage{
+			ChatID:  chatID,
+			Message: message,
+			SendAt:  sendAt,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.logRepository.Log(ctx, &model.Log{
+			Action:  model.ActionMessageSchedule,
+			ChatID:  chatID,
+			Payload: map[string]any{"scheduled_id": id, "send_at": sendAt},
+			Text:    fmt.Sprintf("Scheduled message with id: %v in chat with id: %v", id, chatID),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Print(err)
+		return "", errors.New("failed to schedule message")
+	}
+
+	return id, nil
+}
+
+// ListScheduled implements service.ChatService.
+// Only the caller's own messages are listed, messages of other members stay private until they are sent.
+func (s *chatService) ListScheduled(ctx context.Context, chatID string) ([]*model.ScheduledMessage, error) {
+	username, _, err := s.checkMember(ctx, chatID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := s.scheduledRepository.GetPending(ctx, chatID)
+	if err != nil {
+		log.Print(err)
+		return nil, errors.New("failed to list scheduled messages")
+	}
+
+	own := make([]*model.ScheduledMessage, 0, len(messages))
+	for _, m := range messages {
+		if m.Message.From == username {
+			own = append(own, m)
+		}
+	}
+
+	return own, nil
+}
+
+// CancelScheduled implements service.ChatService.
+func (s *chatService) CancelScheduled(ctx context.Context, id string) error {
+	scheduled, err := s.scheduledRepository.Get(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return status.Error(codes.NotFound, "scheduled message not found")
+	}
+	if err != nil {
+		log.Print(err)
+		return errors.New("failed to get scheduled message")
+	}
+
+	username, _, err := s.checkMember(ctx, scheduled.ChatID, "")
+	if err != nil {
+		return err
+	}
+	if scheduled.Message.From != username {
+		return status.Error(codes.PermissionDenied, "only the author can cancel a scheduled message")
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.scheduledRepository.Cancel(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.logRepository.Log(ctx, &model.Log{
+			Action:  model.ActionMessageCancel,
+			ChatID:  scheduled.ChatID,
+			Payload: map[string]any{"scheduled_id": id},
+			Text:    fmt.Sprintf("Cancelled scheduled message with id: %v", id),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return errors.New("scheduled message not found or already sent")
+	}
+	if err != nil {
+		log.Print(err)
+		return errors.New("failed to cancel scheduled message")
+	}
+
+	return nil
+}
+
+// DeliverScheduled implements service.ChatService.
+func (s *chatService) DeliverScheduled(ctx context.Context) (*model.ScheduledMessage, error) {
+	var (
+		scheduled *model.ScheduledMessage
+		sent      *model.Message
+	)
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		due, errTx := s.scheduledRepository.ClaimDue(ctx, 1)
+		if errTx != nil {
+			return errTx
+		}
+		if len(due) == 0 {
+			return nil
+		}
+		scheduled = due[0]
+
+		// The author was authenticated when the message was scheduled
+		sendCtx := identity.WithPrincipal(ctx, &model.Principal{Username: scheduled.Message.From})
+
+		sent, _, errTx = s.storeMessage(sendCtx, scheduled.ChatID, &model.Message{
+			From:      scheduled.Message.From,
+			Text:      scheduled.Message.Text,
+			Timestamp: time.Now(),
+		}, false)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.scheduledRepository.MarkSent(ctx, scheduled.ID)
+	})
+	if err != nil {
+		return scheduled, err
+	}
+
+	// Members only see the message once the delivery is committed, a rolled back one is retried
+	if sent != nil {
+		s.publish(scheduled.ChatID, sent)
+	}
+
+	return scheduled, nil
+}
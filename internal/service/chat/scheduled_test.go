@@ -0,0 +1,205 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/config"
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/spam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDeliverScheduled(t *testing.T) {
+	tests := []struct {
+		name string
+		// setup prepares the database, the chat "chat" has members alice and bob
+		setup         func(f *fakeDB)
+		wantClaimed   bool
+		wantErr       bool
+		wantStatus    model.ScheduledStatus
+		wantMessages  int
+		wantBroadcast int
+	}{
+		{
+			name: "nothing scheduled",
+			setup: func(f *fakeDB) {
+				f.scheduled = nil
+			},
+		},
+		{
+			name: "not due yet",
+			setup: func(f *fakeDB) {
+				f.scheduled[0].SendAt = time.Now().Add(time.Hour)
+			},
+			wantStatus: model.ScheduledPending,
+		},
+		{
+			name:          "delivered",
+			setup:         func(f *fakeDB) {},
+			wantClaimed:   true,
+			wantStatus:    model.ScheduledSent,
+			wantMessages:  1,
+			wantBroadcast: 1,
+		},
+		{
+			name: "marking as sent fails",
+			setup: func(f *fakeDB) {
+				f.fail["scheduled.MarkSent"] = errors.New("connection lost")
+			},
+			wantClaimed: true,
+			wantErr:     true,
+			wantStatus:  model.ScheduledPending,
+		},
+		{
+			name: "author left the chat",
+			setup: func(f *fakeDB) {
+				f.members["chat"] = f.members["chat"][1:]
+			},
+			wantClaimed: true,
+			wantErr:     true,
+			wantStatus:  model.ScheduledPending,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFakeDB()
+			f.addChat("chat", "alice", "bob")
+			f.scheduled = append(f.scheduled, &model.ScheduledMessage{
+				ID:      "scheduled",
+				ChatID:  "chat",
+				Message: &model.Message{From: "alice", Text: "hello"},
+				SendAt:  time.Now().Add(-time.Second),
+				Status:  model.ScheduledPending,
+			})
+			tt.setup(f)
+
+			s := newTestService(f)
+			ch := listen(t, s, "chat")
+
+			scheduled, err := s.DeliverScheduled(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeliverScheduled() error = %v, want error %v", err, tt.wantErr)
+			}
+			if (scheduled != nil) != tt.wantClaimed {
+				t.Errorf("DeliverScheduled() claimed %v, want %v", scheduled, tt.wantClaimed)
+			}
+			if len(f.scheduled) > 0 && f.scheduled[0].Status != tt.wantStatus {
+				t.Errorf("status = %v, want %v", f.scheduled[0].Status, tt.wantStatus)
+			}
+			if len(f.messages) != tt.wantMessages {
+				t.Errorf("stored %d messages, want %d", len(f.messages), tt.wantMessages)
+			}
+			if got := broadcasts(ch); len(got) != tt.wantBroadcast {
+				t.Errorf("broadcast %d messages, want %d", len(got), tt.wantBroadcast)
+			}
+		})
+	}
+}
+
+// Chats created by another replica have no local channel, they must still get scheduled messages.
+func TestDeliverScheduledChatOfOtherReplica(t *testing.T) {
+	f := newFakeDB()
+	f.addChat("chat", "alice")
+	f.scheduled = append(f.scheduled, &model.ScheduledMessage{
+		ID:      "scheduled",
+		ChatID:  "chat",
+		Message: &model.Message{From: "alice", Text: "hello"},
+		SendAt:  time.Now().Add(-time.Second),
+		Status:  model.ScheduledPending,
+	})
+
+	s := newTestService(f)
+
+	if _, err := s.DeliverScheduled(context.Background()); err != nil {
+		t.Fatalf("DeliverScheduled() error = %v", err)
+	}
+	if f.scheduled[0].Status != model.ScheduledSent {
+		t.Errorf("status = %v, want %v", f.scheduled[0].Status, model.ScheduledSent)
+	}
+}
+
+// Spam is detected when messages are scheduled, so a mute is not rolled back with a failed delivery.
+func TestScheduledSpam(t *testing.T) {
+	tests := []struct {
+		name string
+		// scheduled are texts scheduled by alice, then due messages are delivered
+		scheduled []string
+		// due are texts already waiting for delivery
+		due              []string
+		wantErr          codes.Code
+		wantRestrictions int
+		wantSent         int
+	}{
+		{
+			name:      "distinct texts",
+			scheduled: []string{"one", "two"},
+		},
+		{
+			name:             "copy is refused and muted when scheduled",
+			scheduled:        []string{"same", "same"},
+			wantErr:          codes.PermissionDenied,
+			wantRestrictions: 1,
+		},
+		{
+			name:     "delivery is not checked again",
+			due:      []string{"same", "same", "same"},
+			wantSent: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFakeDB()
+			f.addChat("chat", "alice", "bob")
+			for _, text := range tt.due {
+				f.scheduled = append(f.scheduled, &model.ScheduledMessage{
+					ID:      f.nextID("scheduled"),
+					ChatID:  "chat",
+					Message: &model.Message{From: "alice", Text: text},
+					SendAt:  time.Now().Add(-time.Second),
+					Status:  model.ScheduledPending,
+				})
+			}
+
+			s := newTestService(f)
+			detector, err := spam.NewDetector(config.Spam{
+				Window:          time.Minute,
+				MaxDuplicates:   1,
+				DuplicateAction: string(spam.ActionMute),
+				MuteDuration:    time.Minute,
+			}, spam.NewMemoryStore())
+			if err != nil {
+				t.Fatal(err)
+			}
+			s.spam = detector
+
+			var code codes.Code
+			for _, text := range tt.scheduled {
+				_, err = s.ScheduleMessage(asUser("alice", ""), "chat", &model.Message{Text: text}, time.Now().Add(time.Hour))
+				if err != nil {
+					code = status.Code(err)
+				}
+			}
+			if code != tt.wantErr {
+				t.Errorf("ScheduleMessage() code = %v, want %v", code, tt.wantErr)
+			}
+			if len(f.restrictions) != tt.wantRestrictions {
+				t.Errorf("stored %d restrictions, want %d", len(f.restrictions), tt.wantRestrictions)
+			}
+
+			for range tt.due {
+				if _, err = s.DeliverScheduled(context.Background()); err != nil {
+					t.Errorf("DeliverScheduled() error = %v", err)
+				}
+			}
+			if len(f.messages) != tt.wantSent {
+				t.Errorf("sent %d messages, want %d", len(f.messages), tt.wantSent)
+			}
+		})
+	}
+}
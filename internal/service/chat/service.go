@@ -2,46 +2,94 @@ package chat
 
 import (
 	"sync"
+	"time"
 
+	"github.com/8thgencore/microservice-chat/internal/filter"
 	"github.com/8thgencore/microservice-chat/internal/model"
 	"github.com/8thgencore/microservice-chat/internal/repository"
 	"github.com/8thgencore/microservice-chat/internal/service"
+	"github.com/8thgencore/microservice-chat/internal/spam"
 	"github.com/8thgencore/microservice-common/pkg/db"
 )
 
 const messagesBuffer = 100
 
+// banCheckInterval is how often connected streams check the ban of their user,
+// so bans made on other replicas end the stream without a reconnect.
+const banCheckInterval = 10 * time.Second
+
 type chatService struct {
-	chatRepository     repository.ChatRepository
-	messagesRepository repository.MessagesRepository
-	logRepository      repository.LogRepository
-	txManager          db.TxManager
+	chatRepository         repository.ChatRepository
+	membersRepository      repository.MembersRepository
+	restrictionsRepository repository.RestrictionsRepository
+	messagesRepository     repository.MessagesRepository
+	logRepository          repository.LogRepository
+	scheduledRepository    repository.ScheduledRepository
+	flagsRepository        repository.FlagsRepository
+	reportsRepository      repository.ReportsRepository
+	blocksRepository       repository.BlocksRepository
+	payloadsRepository     repository.PayloadsRepository
+	devicesRepository      repository.DevicesRepository
+	txManager              db.TxManager
+
+	filter filter.Pipeline
+	spam   *spam.Detector
 
 	channels   map[string]chan *model.Message
 	mxChannels sync.RWMutex
 
 	chats  map[string]*chat
 	mxChat sync.RWMutex
+
+	chatCache  *chatCache
+	blockCache *blockCache
 }
 
 type chat struct {
 	streams map[string]model.Stream
-	m       sync.RWMutex
+	// kicks are closed to end the stream of the user, e.g. when they are banned
+	kicks map[string]chan struct{}
+	// includeBlocked marks streams which get messages of users blocked by the member
+	includeBlocked map[string]bool
+	m              sync.RWMutex
 }
 
 // NewService creates new object of service layer.
 func NewService(
 	chatRepository repository.ChatRepository,
+	membersRepository repository.MembersRepository,
+	restrictionsRepository repository.RestrictionsRepository,
 	messagesRepository repository.MessagesRepository,
 	logRepository repository.LogRepository,
+	scheduledRepository repository.ScheduledRepository,
+	flagsRepository repository.FlagsRepository,
+	reportsRepository repository.ReportsRepository,
+	blocksRepository repository.BlocksRepository,
+	payloadsRepository repository.PayloadsRepository,
+	devicesRepository repository.DevicesRepository,
 	txManager db.TxManager,
+	messageFilter filter.Pipeline,
+	spamDetector *spam.Detector,
+	cacheTTL time.Duration,
 ) service.ChatService {
 	return &chatService{
-		chatRepository:     chatRepository,
-		messagesRepository: messagesRepository,
-		logRepository:      logRepository,
-		txManager:          txManager,
-		chats:              make(map[string]*chat),
-		channels:           make(map[string]chan *model.Message),
+		chatRepository:         chatRepository,
+		membersRepository:      membersRepository,
+		restrictionsRepository: restrictionsRepository,
+		messagesRepository:     messagesRepository,
+		logRepository:          logRepository,
+		scheduledRepository:    scheduledRepository,
+		flagsRepository:        flagsRepository,
+		reportsRepository:      reportsRepository,
+		blocksRepository:       blocksRepository,
+		payloadsRepository:     payloadsRepository,
+		devicesRepository:      devicesRepository,
+		txManager:              txManager,
+		filter:                 messageFilter,
+		spam:                   spamDetector,
+		chats:                  make(map[string]*chat),
+		channels:               make(map[string]chan *model.Message),
+		chatCache:              newChatCache(cacheTTL),
+		blockCache:             newBlockCache(cacheTTL),
 	}
 }
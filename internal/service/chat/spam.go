@@ -0,0 +1,96 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/spam"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// spamModerator is recorded as the author of restrictions applied by spam detection.
+const spamModerator = "system:spam"
+
+// checkSpam records the message in the sender's window and refuses it if the sender exceeded a limit.
+func (s *chatService) checkSpam(ctx context.Context, chatID string, from string, text string) error {
+	if s.spam == nil {
+		return nil
+	}
+
+	verdict := s.spam.Check(ctx, from, chatID, text)
+	if verdict == nil {
+		return nil
+	}
+
+	switch verdict.Action {
+	case spam.ActionThrottle:
+		return throttled(fmt.Sprintf("too many messages: %s", verdict.Rule), verdict.RetryAfter)
+	case spam.ActionMute:
+		if err := s.muteSpammer(ctx, from, verdict); err != nil {
+			return err
+		}
+		return status.Errorf(codes.PermissionDenied, "caller is muted for spam: %s", verdict.Rule)
+	default:
+		return status.Errorf(codes.InvalidArgument, "message is rejected as spam: %s", verdict.Rule)
+	}
+}
+
+// muteSpammer mutes the sender in every chat the matching messages were sent to.
+func (s *chatService) muteSpammer(ctx context.Context, username string, verdict *spam.Verdict) error {
+	expiresAt := time.Now().Add(s.spam.MuteDuration())
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		for _, chatID := range verdict.ChatIDs {
+			errTx := s.restrictionsRepository.Upsert(ctx, &model.Restriction{
+				ChatID:    chatID,
+				Username:  username,
+				Kind:      model.RestrictionMute,
+				ExpiresAt: &expiresAt,
+				CreatedBy: spamModerator,
+			})
+			if errTx != nil {
+				return errTx
+			}
+
+			errTx = s.logRepository.Log(ctx, &model.Log{
+				Actor:   spamModerator,
+				Action:  model.ActionMemberMute,
+				ChatID:  chatID,
+				Payload: map[string]any{"username": username, "expires_at": expiresAt, "rule": verdict.Rule},
+				Text: fmt.Sprintf("Muted %v for %v spam until %v in chat with id: %v",
+					username, verdict.Rule, expiresAt.UTC().Format(time.RFC3339), chatID),
+			})
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Print(err)
+		return errors.New("failed to mute user")
+	}
+
+	return nil
+}
+
+// throttled returns ResourceExhausted error telling the client when to retry.
+func throttled(msg string, delay time.Duration) error {
+	st := status.New(codes.ResourceExhausted, msg)
+	withDetails, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(delay),
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
@@ -0,0 +1,141 @@
+package device
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/8thgencore/microservice-chat/internal/identity"
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RegisterDeviceKey implements service.DeviceService.
+// The device ID and key size are validated by the RegisterDeviceKeyRequest rules.
+func (s *deviceService) RegisterDeviceKey(
+	ctx context.Context,
+	deviceID string,
+	publicKey []byte,
+) (*model.DeviceKey, error) {
+	username, err := caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var key *model.DeviceKey
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		// A device registering again replaced its key, e.g. after reinstalling the app
+		errTx := s.devicesRepository.RevokeDevice(ctx, username, deviceID)
+		if errTx != nil && !errors.Is(errTx, repository.ErrNotFound) {
+			return errTx
+		}
+
+		key, errTx = s.devicesRepository.Create(ctx, &model.DeviceKey{
+			Username:  username,
+			DeviceID:  deviceID,
+			PublicKey: publicKey,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.logRepository.Log(ctx, &model.Log{
+			Action:  model.ActionDeviceRegister,
+			Payload: map[string]any{"key_id": key.ID, "device_id": deviceID},
+			Text:    fmt.Sprintf("Registered key %v of device %v of user %v", key.ID, deviceID, username),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return nil, status.Error(codes.Aborted, "device key is being registered concurrently")
+	}
+	if err != nil {
+		log.Print(err)
+		return nil, errors.New("failed to register device key")
+	}
+
+	return key, nil
+}
+
+// ListDeviceKeys implements service.DeviceService.
+func (s *deviceService) ListDeviceKeys(ctx context.Context, username string) ([]*model.DeviceKey, error) {
+	caller, err := caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if username == "" {
+		username = caller
+	}
+
+	keys, err := s.devicesRepository.List(ctx, username)
+	if err != nil {
+		log.Print(err)
+		return nil, errors.New("failed to list device keys")
+	}
+
+	return keys, nil
+}
+
+// RevokeDeviceKey implements service.DeviceService.
+func (s *deviceService) RevokeDeviceKey(ctx context.Context, id string) error {
+	username, err := caller(ctx)
+	if err != nil {
+		return err
+	}
+
+	key, err := s.devicesRepository.Get(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return status.Error(codes.NotFound, "device key not found")
+	}
+	if err != nil {
+		log.Print(err)
+		return errors.New("failed to revoke device key")
+	}
+
+	if principal, _ := identity.FromContext(ctx); key.Username != username && !principal.IsAdmin() {
+		return status.Error(codes.PermissionDenied, "only the owner of the device can revoke its key")
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.devicesRepository.Revoke(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.logRepository.Log(ctx, &model.Log{
+			Action:  model.ActionDeviceRevoke,
+			Payload: map[string]any{"key_id": id, "device_id": key.DeviceID, "username": key.Username},
+			Text:    fmt.Sprintf("Revoked key %v of device %v of user %v", id, key.DeviceID, key.Username),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return status.Error(codes.NotFound, "device key not found")
+	}
+	if err != nil {
+		log.Print(err)
+		return errors.New("failed to revoke device key")
+	}
+
+	return nil
+}
+
+func caller(ctx context.Context) (string, error) {
+	principal, ok := identity.FromContext(ctx)
+	if !ok || principal.Username == "" {
+		return "", status.Error(codes.Unauthenticated, "caller is not authenticated")
+	}
+
+	return principal.Username, nil
+}
@@ -0,0 +1,26 @@
+package device
+
+import (
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"github.com/8thgencore/microservice-chat/internal/service"
+	"github.com/8thgencore/microservice-common/pkg/db"
+)
+
+type deviceService struct {
+	devicesRepository repository.DevicesRepository
+	logRepository     repository.LogRepository
+	txManager         db.TxManager
+}
+
+// NewService creates new object of service layer.
+func NewService(
+	devicesRepository repository.DevicesRepository,
+	logRepository repository.LogRepository,
+	txManager db.TxManager,
+) service.DeviceService {
+	return &deviceService{
+		devicesRepository: devicesRepository,
+		logRepository:     logRepository,
+		txManager:         txManager,
+	}
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/8thgencore/microservice-chat/internal/model"
 )
@@ -10,7 +11,82 @@ import (
 type ChatService interface {
 	Create(ctx context.Context, chat *model.Chat) (string, error)
 	Delete(ctx context.Context, id string) error
-	SendMessage(ctx context.Context, chatID string, message *model.Message) error
-	Connect(chatID string, username string, stream model.Stream) error
+	// SendMessage stores and broadcasts the message. A message repeating the client message ID
+	// of a stored one is not saved again: the stored message is returned with the duplicate flag.
+	SendMessage(ctx context.Context, chatID string, message *model.Message) (*model.Message, bool, error)
+	// Connect streams the chat to the member. Messages of users blocked by the member are left out unless included.
+	Connect(chatID string, username string, includeBlocked bool, stream model.Stream) error
 	InitChannels(ctx context.Context) error
+	ScheduleMessage(ctx context.Context, chatID string, message *model.Message, sendAt time.Time) (string, error)
+	ListScheduled(ctx context.Context, chatID string) ([]*model.ScheduledMessage, error)
+	CancelScheduled(ctx context.Context, id string) error
+	// DeliverScheduled claims one due scheduled message, sends it and broadcasts it once committed.
+	// It returns the claimed message, nil if none is due, and the delivery error.
+	DeliverScheduled(ctx context.Context) (*model.ScheduledMessage, error)
+	SetMessageTTL(ctx context.Context, chatID string, ttl time.Duration) error
+	// DeleteExpiredMessages removes up to limit expired messages and notifies connected streams.
+	DeleteExpiredMessages(ctx context.Context, limit uint64) (int, error)
+	SetRetention(ctx context.Context, chatID string, retention time.Duration) error
+	// PurgeMessages removes messages older than the retention of their chat and records the run.
+	PurgeMessages(ctx context.Context, opts model.PurgeOptions) (*model.PurgeReport, error)
+	// ImportMessages stores one batch of historical messages without broadcasting them.
+	// It returns the number of imported messages and messages skipped as already imported.
+	ImportMessages(ctx context.Context, chatID string, messages []*model.Message) (int, int, error)
+	// PromoteMember makes a regular member a chat admin.
+	PromoteMember(ctx context.Context, chatID string, username string) error
+	// DemoteMember makes a chat admin a regular member.
+	DemoteMember(ctx context.Context, chatID string, username string) error
+	// TransferOwnership makes the member the chat owner, the previous owner becomes admin.
+	TransferOwnership(ctx context.Context, chatID string, username string) error
+	// BanUser keeps the user out of the chat and ends their stream, zero duration bans permanently.
+	BanUser(ctx context.Context, chatID string, username string, duration time.Duration) error
+	UnbanUser(ctx context.Context, chatID string, username string) error
+	// MuteUser forbids the user to send messages to the chat, zero duration mutes permanently.
+	MuteUser(ctx context.Context, chatID string, username string, duration time.Duration) error
+	UnmuteUser(ctx context.Context, chatID string, username string) error
+	// ReportMessage reports the message to the chat moderators and returns the report ID.
+	ReportMessage(ctx context.Context, messageID string, reason string) (string, error)
+	// ListReports returns a page of reports matching the filter, oldest first, and the token of the next page.
+	// Empty status lists open reports, the next page token is empty on the last page.
+	ListReports(ctx context.Context, filter model.ReportFilter, pageToken string) ([]*model.Report, string, error)
+	// ResolveReport closes the open report by dismissing it, deleting the message or banning its author.
+	ResolveReport(ctx context.Context, id string, resolution model.ReportStatus) error
+	// BlockUser hides the user's messages from the caller and refuses direct chats between them.
+	BlockUser(ctx context.Context, username string) error
+	UnblockUser(ctx context.Context, username string) error
+	// ListBlocked returns users blocked by the caller.
+	ListBlocked(ctx context.Context) ([]*model.Block, error)
+	// ExportUserData sends every record kept about the user, starting with the user record.
+	ExportUserData(ctx context.Context, username string, send func(*model.ExportRecord) error) error
+	// EraseUser removes the user from chats and replaces them with a pseudonym as author of their messages.
+	// Messages of other users are kept.
+	EraseUser(ctx context.Context, username string) (*model.Erasure, error)
+}
+
+// BotService is the interface for bot accounts management and authentication.
+type BotService interface {
+	// CreateBot registers a bot and returns it with its API key. The key is not stored and can not be shown again.
+	CreateBot(ctx context.Context, name string) (*model.Bot, string, error)
+	// RotateBotKey replaces the API key of the bot, the previous key stops working at once.
+	RotateBotKey(ctx context.Context, id string) (string, error)
+	RevokeBot(ctx context.Context, id string) error
+	// Authenticate returns the bot owning the API key.
+	Authenticate(ctx context.Context, apiKey string) (*model.Bot, error)
+}
+
+// AuditService is the interface for reading the audit log.
+type AuditService interface {
+	// QueryAuditLog returns a page of records matching the filter, newest first, and the token of the next page.
+	// The next page token is empty on the last page.
+	QueryAuditLog(ctx context.Context, filter model.LogFilter, pageToken string) ([]*model.Log, string, error)
+}
+
+// DeviceService is the interface for the registry of device public keys used for end-to-end encryption.
+type DeviceService interface {
+	// RegisterDeviceKey stores the public key of the caller's device, replacing the previous key of the device.
+	RegisterDeviceKey(ctx context.Context, deviceID string, publicKey []byte) (*model.DeviceKey, error)
+	// ListDeviceKeys returns active device keys of the user, empty username lists the caller's keys.
+	ListDeviceKeys(ctx context.Context, username string) ([]*model.DeviceKey, error)
+	// RevokeDeviceKey revokes the key. Only its owner and administrators may revoke it.
+	RevokeDeviceKey(ctx context.Context, id string) error
 }
@@ -0,0 +1,71 @@
+package spam
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type memoryStore struct {
+	m         sync.Mutex
+	events    map[string][]Event
+	lastSweep time.Time
+}
+
+// NewMemoryStore creates store keeping events in memory of this replica.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		events:    make(map[string][]Event),
+		lastSweep: time.Now(),
+	}
+}
+
+func (s *memoryStore) Add(_ context.Context, username string, event Event, window time.Duration, keep int) ([]Event, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	since := event.At.Add(-window)
+	events := append(inWindow(s.events[username], since), event)
+	if len(events) > keep {
+		events = events[len(events)-keep:]
+	}
+	s.events[username] = events
+
+	// Users who stopped sending are forgotten once per window
+	if event.At.Sub(s.lastSweep) > window {
+		for u, e := range s.events {
+			if len(inWindow(e, since)) == 0 {
+				delete(s.events, u)
+			}
+		}
+		s.lastSweep = event.At
+	}
+
+	return append([]Event(nil), events...), nil
+}
+
+func (s *memoryStore) Remove(_ context.Context, username string, event Event) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	events := s.events[username]
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i] == event {
+			s.events[username] = append(events[:i:i], events[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+// inWindow drops events older than since, events are ordered by time.
+func inWindow(events []Event, since time.Time) []Event {
+	for i, e := range events {
+		if e.At.After(since) {
+			return events[i:]
+		}
+	}
+
+	return nil
+}
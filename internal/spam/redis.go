@@ -0,0 +1,76 @@
+package spam
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+const keyPrefix = "chat:spam:"
+
+type redisStore struct {
+	client goredis.Cmdable
+}
+
+// NewRedisStore creates store sharing events between replicas through Redis.
+func NewRedisStore(client goredis.Cmdable) Store {
+	return &redisStore{client: client}
+}
+
+func (s *redisStore) Add(ctx context.Context, username string, event Event, window time.Duration, keep int) ([]Event, error) {
+	key := keyPrefix + username
+
+	// The list is kept newest first and capped, so it never holds more than one window of a flood.
+	// Commands run in one transaction, so concurrent messages of the user see each other.
+	var values *goredis.StringSliceCmd
+	_, err := s.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.LPush(ctx, key, encode(event))
+		pipe.LTrim(ctx, key, 0, int64(keep-1))
+		pipe.Expire(ctx, key, window)
+		values = pipe.LRange(ctx, key, 0, -1)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	since := event.At.Add(-window)
+	events := make([]Event, 0, len(values.Val()))
+	for i := len(values.Val()) - 1; i >= 0; i-- {
+		e, errDecode := decode(values.Val()[i])
+		if errDecode != nil {
+			return nil, errDecode
+		}
+		if e.At.After(since) {
+			events = append(events, e)
+		}
+	}
+
+	return events, nil
+}
+
+func (s *redisStore) Remove(ctx context.Context, username string, event Event) error {
+	return s.client.LRem(ctx, keyPrefix+username, 1, encode(event)).Err()
+}
+
+func encode(e Event) string {
+	return fmt.Sprintf("%d|%s|%s", e.At.UnixNano(), e.ChatID, e.Digest)
+}
+
+func decode(value string) (Event, error) {
+	parts := strings.SplitN(value, "|", 3)
+	if len(parts) != 3 {
+		return Event{}, fmt.Errorf("invalid spam event %q", value)
+	}
+
+	at, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return Event{}, fmt.Errorf("invalid spam event %q: %w", value, err)
+	}
+
+	return Event{ChatID: parts[1], Digest: parts[2], At: time.Unix(0, at)}, nil
+}
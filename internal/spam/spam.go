@@ -0,0 +1,219 @@
+// Package spam detects floods and repeated messages of a user within a sliding window.
+package spam
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/config"
+	"github.com/8thgencore/microservice-chat/internal/metrics"
+)
+
+// Action is the response to detected spam.
+type Action string
+
+const (
+	// ActionThrottle asks the sender to retry when the window allows it.
+	ActionThrottle Action = "throttle"
+	// ActionReject refuses the message.
+	ActionReject Action = "reject"
+	// ActionMute refuses the message and mutes the sender in the chats it was sent to.
+	ActionMute Action = "mute"
+)
+
+// Rules the window is checked against.
+const (
+	// RuleFlood is too many messages of the user.
+	RuleFlood = "flood"
+	// RuleDuplicate is the same text sent too many times.
+	RuleDuplicate = "duplicate"
+	// RuleCrossChat is the same text sent to too many chats.
+	RuleCrossChat = "cross_chat"
+)
+
+// defaultKeep is how many events are kept per user when floods are not limited.
+const defaultKeep = 100
+
+// Event is a message sent by the user.
+type Event struct {
+	ChatID string
+	// Digest identifies the text regardless of case and spacing, it is empty for messages without text.
+	Digest string
+	At     time.Time
+}
+
+// Store keeps recent events of users.
+type Store interface {
+	// Add records the event and returns events of the user within the window, the new one included.
+	// At most keep latest events are stored.
+	Add(ctx context.Context, username string, event Event, window time.Duration, keep int) ([]Event, error)
+	// Remove forgets the event, so refused messages do not count against the user.
+	Remove(ctx context.Context, username string, event Event) error
+}
+
+// Verdict is detected spam.
+type Verdict struct {
+	Rule   string
+	Action Action
+	// RetryAfter is when the oldest matching message leaves the window.
+	RetryAfter time.Duration
+	// ChatIDs are the chats the matching messages were sent to.
+	ChatIDs []string
+}
+
+type rule struct {
+	name   string
+	limit  int
+	action Action
+}
+
+// Detector checks messages of users against flood and duplicate limits.
+type Detector struct {
+	store        Store
+	window       time.Duration
+	keep         int
+	rules        []rule
+	muteDuration time.Duration
+}
+
+// NewDetector creates spam detector keeping events in the store.
+// Rules with zero limit are disabled, zero window disables detection.
+func NewDetector(cfg config.Spam, store Store) (*Detector, error) {
+	rules := []rule{
+		// The most specific rule is reported first
+		{name: RuleCrossChat, limit: cfg.MaxChats, action: Action(cfg.CrossChatAction)},
+		{name: RuleDuplicate, limit: cfg.MaxDuplicates, action: Action(cfg.DuplicateAction)},
+		{name: RuleFlood, limit: cfg.MaxMessages, action: Action(cfg.FloodAction)},
+	}
+
+	keep := cfg.MaxMessages + 1
+	if cfg.MaxMessages == 0 {
+		keep = defaultKeep
+	}
+
+	enabled := make([]rule, 0, len(rules))
+	for _, r := range rules {
+		if r.limit <= 0 {
+			continue
+		}
+		switch r.action {
+		case ActionThrottle, ActionReject, ActionMute:
+		default:
+			return nil, fmt.Errorf("unknown spam action %q of %s rule", r.action, r.name)
+		}
+
+		keep = max(keep, r.limit+1)
+		enabled = append(enabled, r)
+	}
+
+	return &Detector{
+		store:        store,
+		window:       cfg.Window,
+		keep:         keep,
+		rules:        enabled,
+		muteDuration: cfg.MuteDuration,
+	}, nil
+}
+
+// MuteDuration is how long senders are muted by ActionMute.
+func (d *Detector) MuteDuration() time.Duration {
+	return d.muteDuration
+}
+
+// Check records the message and returns the verdict if the user exceeded a limit, nil otherwise.
+// Messages are allowed if the store fails, so a store outage does not stop the chat.
+func (d *Detector) Check(ctx context.Context, username string, chatID string, text string) *Verdict {
+	if d.window <= 0 || len(d.rules) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	event := Event{ChatID: chatID, Digest: digest(text), At: now}
+
+	events, err := d.store.Add(ctx, username, event, d.window, d.keep)
+	if err != nil {
+		log.Printf("failed to check spam of %s: %v", username, err)
+		metrics.IncSpamStoreErrors()
+		return nil
+	}
+
+	for _, r := range d.rules {
+		matched := match(r.name, events, event)
+		chats := chatsOf(matched)
+
+		count := len(matched)
+		if r.name == RuleCrossChat {
+			count = len(chats)
+		}
+		if count <= r.limit {
+			continue
+		}
+
+		metrics.IncSpamDetected(r.name, string(r.action))
+
+		// Every action refuses the message, a retried message must not count as a copy of itself
+		if errRemove := d.store.Remove(ctx, username, event); errRemove != nil {
+			log.Printf("failed to forget refused message of %s: %v", username, errRemove)
+			metrics.IncSpamStoreErrors()
+		}
+
+		return &Verdict{
+			Rule:       r.name,
+			Action:     r.action,
+			RetryAfter: matched[0].At.Add(d.window).Sub(now),
+			ChatIDs:    chats,
+		}
+	}
+
+	return nil
+}
+
+// match returns events of the window the rule counts for the new event, oldest first.
+func match(rule string, events []Event, event Event) []Event {
+	if rule == RuleFlood {
+		return events
+	}
+	// Messages without text, e.g. end-to-end encrypted ones, can not be compared
+	if event.Digest == "" {
+		return nil
+	}
+
+	res := make([]Event, 0, len(events))
+	for _, e := range events {
+		if e.Digest == event.Digest {
+			res = append(res, e)
+		}
+	}
+
+	return res
+}
+
+func chatsOf(events []Event) []string {
+	seen := make(map[string]struct{}, len(events))
+	res := make([]string, 0, len(events))
+	for _, e := range events {
+		if _, ok := seen[e.ChatID]; ok {
+			continue
+		}
+		seen[e.ChatID] = struct{}{}
+		res = append(res, e.ChatID)
+	}
+
+	return res
+}
+
+// digest identifies the text ignoring case and spacing, so trivially changed copies match.
+func digest(text string) string {
+	if text == "" {
+		return ""
+	}
+	normalized := strings.Join(strings.Fields(strings.ToLower(text)), " ")
+	sum := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(sum[:16])
+}
@@ -0,0 +1,100 @@
+package spam
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/config"
+	"github.com/8thgencore/microservice-chat/internal/metrics"
+)
+
+func TestMain(m *testing.M) {
+	if err := metrics.Init(context.Background()); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+type message struct {
+	chatID string
+	text   string
+}
+
+func TestDetectorCheck(t *testing.T) {
+	cfg := config.Spam{
+		Window:          time.Minute,
+		MaxMessages:     4,
+		MaxDuplicates:   2,
+		MaxChats:        2,
+		FloodAction:     string(ActionThrottle),
+		DuplicateAction: string(ActionReject),
+		CrossChatAction: string(ActionMute),
+	}
+
+	tests := []struct {
+		name     string
+		messages []message
+		// want is the rule detected for every message, empty if it is allowed
+		want []string
+	}{
+		{
+			name:     "different texts",
+			messages: []message{{"a", "one"}, {"a", "two"}, {"a", "three"}},
+			want:     []string{"", "", ""},
+		},
+		{
+			name:     "flood",
+			messages: []message{{"a", "1"}, {"a", "2"}, {"a", "3"}, {"a", "4"}, {"a", "5"}},
+			want:     []string{"", "", "", "", RuleFlood},
+		},
+		{
+			name:     "duplicates ignoring case and spacing",
+			messages: []message{{"a", "buy now"}, {"a", "Buy  now"}, {"a", "BUY NOW"}},
+			want:     []string{"", "", RuleDuplicate},
+		},
+		{
+			name:     "refused copies are not counted",
+			messages: []message{{"a", "hi"}, {"a", "hi"}, {"a", "hi"}, {"a", "hi"}, {"a", "other"}},
+			want:     []string{"", "", RuleDuplicate, RuleDuplicate, ""},
+		},
+		{
+			name:     "cross chat",
+			messages: []message{{"a", "promo"}, {"b", "promo"}, {"c", "promo"}},
+			want:     []string{"", "", RuleCrossChat},
+		},
+		{
+			name:     "messages without text only count as flood",
+			messages: []message{{"a", ""}, {"b", ""}, {"c", ""}, {"d", ""}, {"e", ""}},
+			want:     []string{"", "", "", "", RuleFlood},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewDetector(cfg, NewMemoryStore())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for i, m := range tt.messages {
+				got := ""
+				if v := d.Check(context.Background(), "user", m.chatID, m.text); v != nil {
+					got = v.Rule
+				}
+				if got != tt.want[i] {
+					t.Errorf("message %d %q: got rule %q, want %q", i, m.text, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestNewDetectorUnknownAction(t *testing.T) {
+	_, err := NewDetector(config.Spam{Window: time.Minute, MaxMessages: 1, FloodAction: "ban"}, NewMemoryStore())
+	if err == nil {
+		t.Error("expected error for unknown action")
+	}
+}
@@ -0,0 +1,61 @@
+// Package purger deletes messages which are older than the chat retention.
+package purger
+
+import (
+	"context"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/config"
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/service"
+	"github.com/8thgencore/microservice-common/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Worker periodically runs the retention purge.
+type Worker struct {
+	cfg config.Retention
+
+	chatService service.ChatService
+}
+
+// NewWorker creates new purger worker.
+func NewWorker(cfg config.Retention, chatService service.ChatService) *Worker {
+	return &Worker{
+		cfg:         cfg,
+		chatService: chatService,
+	}
+}
+
+// Run purges messages until the context is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.purge(ctx)
+		}
+	}
+}
+
+func (w *Worker) purge(ctx context.Context) {
+	report, err := w.chatService.PurgeMessages(ctx, model.PurgeOptions{
+		DefaultRetention: w.cfg.DefaultPeriod,
+		BatchSize:        w.cfg.BatchSize,
+		DryRun:           w.cfg.DryRun,
+	})
+	if err != nil {
+		logger.Error("failed to purge messages", zap.Error(err))
+		return
+	}
+
+	logger.Info("retention purge finished",
+		zap.Bool("dry_run", report.DryRun),
+		zap.Int("messages", report.Deleted),
+		zap.Any("chats", report.Chats),
+	)
+}
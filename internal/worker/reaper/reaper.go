@@ -0,0 +1,57 @@
+// Package reaper deletes expired messages.
+package reaper
+
+import (
+	"context"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/config"
+	"github.com/8thgencore/microservice-chat/internal/service"
+	"github.com/8thgencore/microservice-common/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Worker periodically removes expired messages in batches.
+// Expired rows are locked with SKIP LOCKED, so several replicas can reap at the same time.
+type Worker struct {
+	cfg config.Reaper
+
+	chatService service.ChatService
+}
+
+// NewWorker creates new reaper worker.
+func NewWorker(cfg config.Reaper, chatService service.ChatService) *Worker {
+	return &Worker{
+		cfg:         cfg,
+		chatService: chatService,
+	}
+}
+
+// Run removes expired messages until the context is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.reap(ctx)
+		}
+	}
+}
+
+func (w *Worker) reap(ctx context.Context) {
+	// Keep deleting while batches come back full, so a backlog is cleared in one run.
+	for ctx.Err() == nil {
+		deleted, err := w.chatService.DeleteExpiredMessages(ctx, w.cfg.BatchSize)
+		if err != nil {
+			logger.Error("failed to delete expired messages", zap.Error(err))
+			return
+		}
+		if uint64(deleted) < w.cfg.BatchSize {
+			return
+		}
+	}
+}
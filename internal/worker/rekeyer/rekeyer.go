@@ -0,0 +1,78 @@
+// Package rekeyer rotates chat data keys and re-encrypts messages under old keys.
+package rekeyer
+
+import (
+	"context"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/config"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"github.com/8thgencore/microservice-common/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Worker periodically rotates data keys older than the rotation period and re-encrypts
+// plaintext messages and messages under old keys in batches.
+// Concurrent updates are detected per row, so several replicas can run it at the same time.
+type Worker struct {
+	cfg config.Encryption
+
+	messagesRepository repository.MessagesRepository
+}
+
+// NewWorker creates new rekeyer worker.
+func NewWorker(cfg config.Encryption, messagesRepository repository.MessagesRepository) *Worker {
+	return &Worker{
+		cfg:                cfg,
+		messagesRepository: messagesRepository,
+	}
+}
+
+// Run rotates keys and re-encrypts messages until the context is cancelled.
+// It returns at once if encryption is disabled.
+func (w *Worker) Run(ctx context.Context) {
+	if w.cfg.KeyFile == "" {
+		return
+	}
+
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.rekey(ctx)
+		}
+	}
+}
+
+func (w *Worker) rekey(ctx context.Context) {
+	rotated, err := w.messagesRepository.RotateKeys(ctx, w.cfg.KeyRotationPeriod)
+	if err != nil {
+		logger.Error("failed to rotate chat keys", zap.Error(err))
+		return
+	}
+	if rotated > 0 {
+		logger.Info("chat keys rotated", zap.Int("count", rotated))
+	}
+
+	// Keep re-encrypting while batches come back full, so a backlog is cleared in one run.
+	// Messages which fail are skipped until the next run, so they do not hold back the rest.
+	var after string
+	for ctx.Err() == nil {
+		res, err := w.messagesRepository.Reencrypt(ctx, after, w.cfg.BatchSize)
+		if err != nil {
+			logger.Error("failed to re-encrypt messages", zap.Error(err))
+			return
+		}
+		for _, err = range res.Failed {
+			logger.Error("failed to re-encrypt message", zap.Error(err))
+		}
+		if res.Count == 0 || uint64(res.Count) < w.cfg.BatchSize {
+			return
+		}
+		after = res.Last
+	}
+}
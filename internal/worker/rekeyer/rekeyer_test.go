@@ -0,0 +1,109 @@
+package rekeyer
+
+import (
+	"context"
+	"errors"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/config"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"github.com/8thgencore/microservice-common/pkg/logger"
+)
+
+func TestMain(m *testing.M) {
+	logger.Init("test")
+	os.Exit(m.Run())
+}
+
+// fakeMessagesRepository pages through rows which need re-encryption, failing the broken ones.
+type fakeMessagesRepository struct {
+	repository.MessagesRepository
+
+	rows   []string
+	broken map[string]bool
+	done   []string
+	afters []string
+}
+
+func (r *fakeMessagesRepository) RotateKeys(context.Context, time.Duration) (int, error) {
+	return 0, nil
+}
+
+func (r *fakeMessagesRepository) Reencrypt(
+	_ context.Context,
+	after string,
+	limit uint64,
+) (*repository.ReencryptResult, error) {
+	r.afters = append(r.afters, after)
+
+	res := &repository.ReencryptResult{}
+	for _, id := range r.rows {
+		if id <= after || uint64(res.Count) == limit {
+			continue
+		}
+		res.Count++
+		res.Last = id
+		if r.broken[id] {
+			res.Failed = append(res.Failed, errors.New("broken "+id))
+			continue
+		}
+		r.done = append(r.done, id)
+	}
+	r.rows = slices.DeleteFunc(r.rows, func(id string) bool { return slices.Contains(r.done, id) })
+
+	return res, nil
+}
+
+func TestRekeySkipsFailedMessages(t *testing.T) {
+	tests := []struct {
+		name       string
+		rows       []string
+		broken     map[string]bool
+		batchSize  uint64
+		wantDone   []string
+		wantAfters []string
+	}{
+		{
+			name:       "no failures",
+			rows:       []string{"a", "b", "c"},
+			batchSize:  2,
+			wantDone:   []string{"a", "b", "c"},
+			wantAfters: []string{"", "b"},
+		},
+		{
+			name:       "failed batch does not stop later rows",
+			rows:       []string{"a", "b", "c", "d", "e"},
+			broken:     map[string]bool{"a": true, "b": true},
+			batchSize:  2,
+			wantDone:   []string{"c", "d", "e"},
+			wantAfters: []string{"", "b", "d"},
+		},
+		{
+			name:       "full batch followed by an empty one",
+			rows:       []string{"a", "b"},
+			broken:     map[string]bool{"b": true},
+			batchSize:  2,
+			wantDone:   []string{"a"},
+			wantAfters: []string{"", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeMessagesRepository{rows: tt.rows, broken: tt.broken}
+			w := NewWorker(config.Encryption{BatchSize: tt.batchSize}, repo)
+
+			w.rekey(context.Background())
+
+			if !slices.Equal(repo.done, tt.wantDone) {
+				t.Errorf("re-encrypted %v, want %v", repo.done, tt.wantDone)
+			}
+			if !slices.Equal(repo.afters, tt.wantAfters) {
+				t.Errorf("batches started after %q, want %q", repo.afters, tt.wantAfters)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Package scheduler delivers scheduled messages when their send time comes.
+package scheduler
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/config"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"github.com/8thgencore/microservice-chat/internal/service"
+	"github.com/8thgencore/microservice-common/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Worker polls the scheduled messages table and sends due messages.
+//
+// Every message is claimed with FOR UPDATE SKIP LOCKED in its own transaction, which also
+// stores the message and marks it as sent. Several replicas can therefore run the worker
+// at the same time, and a message left pending by a crashed replica is picked up again.
+type Worker struct {
+	cfg config.Scheduler
+
+	scheduledRepository repository.ScheduledRepository
+	chatService         service.ChatService
+}
+
+// NewWorker creates new scheduler worker.
+func NewWorker(
+	cfg config.Scheduler,
+	scheduledRepository repository.ScheduledRepository,
+	chatService service.ChatService,
+) *Worker {
+	return &Worker{
+		cfg:                 cfg,
+		scheduledRepository: scheduledRepository,
+		chatService:         chatService,
+	}
+}
+
+// Run polls for due messages until the context is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.deliverDue(ctx)
+		}
+	}
+}
+
+func (w *Worker) deliverDue(ctx context.Context) {
+	for i := 0; i < w.cfg.BatchSize; i++ {
+		if ctx.Err() != nil {
+			return
+		}
+
+		claimed, err := w.deliverNext(ctx)
+		if err != nil {
+			logger.Error("failed to deliver scheduled message", zap.Error(err))
+		}
+		if !claimed {
+			return
+		}
+	}
+}
+
+// deliverNext sends one due message and reports whether there was a message to send.
+func (w *Worker) deliverNext(ctx context.Context) (bool, error) {
+	scheduled, err := w.chatService.DeliverScheduled(ctx)
+	if scheduled == nil || err == nil {
+		return scheduled != nil, err
+	}
+
+	// The transaction is rolled back, so the failure is recorded separately.
+	// Permanent failures would fail again, so the message runs out of attempts at once.
+	maxAttempts := w.cfg.MaxAttempts
+	if isPermanent(err) {
+		maxAttempts = 0
+	}
+	errMark := w.scheduledRepository.MarkFailed(
+		ctx, scheduled.ID, err.Error(), time.Now().Add(w.backoff(scheduled.Attempts)), maxAttempts,
+	)
+	if errMark != nil {
+		logger.Error("failed to record scheduled message failure", zap.Error(errMark))
+	}
+
+	return true, err
+}
+
+// backoff returns exponential delay with jitter before the next delivery attempt.
+func (w *Worker) backoff(attempts int) time.Duration {
+	delay := w.cfg.RetryDelay << min(attempts, 10)
+
+	return delay/2 + rand.N(delay/2+1) //nolint:gosec
+}
+
+// isPermanent reports whether the delivery can not succeed on a retry: the author was banned, muted or left,
+// the chat is gone or does not take the message any more, or the filters reject the text.
+func isPermanent(err error) bool {
+	switch status.Code(err) {
+	case codes.PermissionDenied, codes.NotFound, codes.FailedPrecondition, codes.InvalidArgument:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,113 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/8thgencore/microservice-chat/internal/config"
+	"github.com/8thgencore/microservice-chat/internal/model"
+	"github.com/8thgencore/microservice-chat/internal/repository"
+	"github.com/8thgencore/microservice-chat/internal/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeChatService delivers one message with the given result.
+type fakeChatService struct {
+	service.ChatService
+	err error
+}
+
+func (s *fakeChatService) DeliverScheduled(context.Context) (*model.ScheduledMessage, error) {
+	return &model.ScheduledMessage{ID: "scheduled", Attempts: 1}, s.err
+}
+
+// fakeScheduledRepository records the attempts limit of the failure.
+type fakeScheduledRepository struct {
+	repository.ScheduledRepository
+	marked      bool
+	maxAttempts int
+}
+
+func (r *fakeScheduledRepository) MarkFailed(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ time.Time,
+	maxAttempts int,
+) error {
+	r.marked = true
+	r.maxAttempts = maxAttempts
+
+	return nil
+}
+
+func TestDeliverNextFailures(t *testing.T) {
+	tests := []struct {
+		name            string
+		err             error
+		wantMarked      bool
+		wantMaxAttempts int
+	}{
+		{name: "delivered"},
+		{
+			name:            "database failure is retried",
+			err:             errors.New("failed to save message"),
+			wantMarked:      true,
+			wantMaxAttempts: 5,
+		},
+		{
+			name:            "throttled author is retried",
+			err:             status.Error(codes.ResourceExhausted, "too many messages"),
+			wantMarked:      true,
+			wantMaxAttempts: 5,
+		},
+		{
+			name:       "banned author fails at once",
+			err:        status.Error(codes.PermissionDenied, "caller is banned in the chat"),
+			wantMarked: true,
+		},
+		{
+			name:       "deleted chat fails at once",
+			err:        status.Error(codes.NotFound, "chat not found"),
+			wantMarked: true,
+		},
+		{
+			name:       "end-to-end encrypted chat fails at once",
+			err:        status.Error(codes.FailedPrecondition, "scheduling messages is not available"),
+			wantMarked: true,
+		},
+		{
+			name:       "rejected text fails at once",
+			err:        status.Error(codes.InvalidArgument, "message is rejected"),
+			wantMarked: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeScheduledRepository{}
+			w := NewWorker(
+				config.Scheduler{MaxAttempts: 5, RetryDelay: time.Second},
+				repo,
+				&fakeChatService{err: tt.err},
+			)
+
+			claimed, err := w.deliverNext(context.Background())
+			if !claimed {
+				t.Errorf("deliverNext() claimed nothing")
+			}
+			if !errors.Is(err, tt.err) {
+				t.Errorf("deliverNext() error = %v, want %v", err, tt.err)
+			}
+			if repo.marked != tt.wantMarked {
+				t.Fatalf("failure recorded %v, want %v", repo.marked, tt.wantMarked)
+			}
+			if repo.maxAttempts != tt.wantMaxAttempts {
+				t.Errorf("max attempts = %d, want %d", repo.maxAttempts, tt.wantMaxAttempts)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    IF NOT EXISTS scheduled_messages (
+        id uuid PRIMARY KEY DEFAULT gen_random_uuid (),
+        chat_id uuid NOT NULL references chats (id) ON DELETE CASCADE,
+        from_user text,
+        text text,
+        key_version int,
+        send_at timestamptz NOT NULL,
+        status text NOT NULL DEFAULT 'pending',
+        attempts integer NOT NULL DEFAULT 0,
+        last_error text,
+        created_at timestamptz NOT NULL DEFAULT now (),
+        sent_at timestamptz
+    );
+
+CREATE INDEX IF NOT EXISTS scheduled_messages_due_idx ON scheduled_messages (send_at)
+WHERE
+    status = 'pending';
+
+CREATE INDEX IF NOT EXISTS scheduled_messages_chat_id_idx ON scheduled_messages (chat_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS scheduled_messages;
+
+-- +goose StatementEnd
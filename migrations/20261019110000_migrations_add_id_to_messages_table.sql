@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE messages
+ADD COLUMN IF NOT EXISTS id uuid NOT NULL DEFAULT gen_random_uuid ();
+
+ALTER TABLE messages
+ADD CONSTRAINT messages_pkey PRIMARY KEY (id);
+
+CREATE INDEX IF NOT EXISTS messages_chat_id_timestamp_idx ON messages (chat_id, timestamp);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS messages_chat_id_timestamp_idx;
+
+ALTER TABLE messages
+DROP CONSTRAINT IF EXISTS messages_pkey;
+
+ALTER TABLE messages
+DROP COLUMN IF EXISTS id;
+
+-- +goose StatementEnd
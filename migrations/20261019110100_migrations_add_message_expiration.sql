@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE messages
+ADD COLUMN IF NOT EXISTS expires_at timestamptz;
+
+CREATE INDEX IF NOT EXISTS messages_expires_at_idx ON messages (expires_at)
+WHERE
+    expires_at IS NOT NULL;
+
+ALTER TABLE chats
+ADD COLUMN IF NOT EXISTS message_ttl_seconds bigint;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE chats
+DROP COLUMN IF EXISTS message_ttl_seconds;
+
+DROP INDEX IF EXISTS messages_expires_at_idx;
+
+ALTER TABLE messages
+DROP COLUMN IF EXISTS expires_at;
+
+-- +goose StatementEnd
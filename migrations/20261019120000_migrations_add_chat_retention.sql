@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE chats
+ADD COLUMN IF NOT EXISTS retention_seconds bigint;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE chats
+DROP COLUMN IF EXISTS retention_seconds;
+
+-- +goose StatementEnd
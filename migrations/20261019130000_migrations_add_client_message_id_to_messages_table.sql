@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE messages
+ADD COLUMN IF NOT EXISTS client_message_id text;
+
+ALTER TABLE messages
+ADD CONSTRAINT messages_chat_id_from_user_client_message_id_key UNIQUE (chat_id, from_user, client_message_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE messages
+DROP CONSTRAINT IF EXISTS messages_chat_id_from_user_client_message_id_key;
+
+ALTER TABLE messages
+DROP COLUMN IF EXISTS client_message_id;
+
+-- +goose StatementEnd
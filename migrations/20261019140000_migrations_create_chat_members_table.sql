@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    IF NOT EXISTS chat_members (
+        chat_id uuid NOT NULL references chats (id) ON DELETE CASCADE,
+        username text NOT NULL,
+        role text NOT NULL DEFAULT 'member',
+        created_at timestamptz NOT NULL DEFAULT now (),
+        PRIMARY KEY (chat_id, username)
+    );
+
+CREATE UNIQUE INDEX IF NOT EXISTS chat_members_owner_idx ON chat_members (chat_id)
+WHERE
+    role = 'owner';
+
+-- Existing chats have no known creator, their members start as plain members
+INSERT INTO
+    chat_members (chat_id, username)
+SELECT DISTINCT
+    id,
+    unnest(usernames)
+FROM
+    chats
+ON CONFLICT DO NOTHING;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS chat_members;
+
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    IF NOT EXISTS bots (
+        id uuid PRIMARY KEY DEFAULT gen_random_uuid (),
+        name text NOT NULL UNIQUE,
+        key_hash text NOT NULL UNIQUE,
+        created_by text NOT NULL,
+        created_at timestamptz NOT NULL DEFAULT now (),
+        key_rotated_at timestamptz NOT NULL DEFAULT now (),
+        revoked_at timestamptz
+    );
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS bots;
+
+-- +goose StatementEnd
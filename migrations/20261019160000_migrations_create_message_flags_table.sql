@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    IF NOT EXISTS message_flags (
+        id uuid PRIMARY KEY DEFAULT gen_random_uuid (),
+        message_id uuid NOT NULL references messages (id) ON DELETE CASCADE,
+        chat_id uuid NOT NULL references chats (id) ON DELETE CASCADE,
+        reason text NOT NULL,
+        created_at timestamptz NOT NULL DEFAULT now ()
+    );
+
+CREATE INDEX IF NOT EXISTS message_flags_chat_id_idx ON message_flags (chat_id, created_at);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS message_flags;
+
+-- +goose StatementEnd
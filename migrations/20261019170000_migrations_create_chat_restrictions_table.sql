@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    IF NOT EXISTS chat_restrictions (
+        chat_id uuid NOT NULL references chats (id) ON DELETE CASCADE,
+        username text NOT NULL,
+        kind text NOT NULL,
+        expires_at timestamptz,
+        created_by text NOT NULL,
+        created_at timestamptz NOT NULL DEFAULT now (),
+        PRIMARY KEY (chat_id, username, kind)
+    );
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS chat_restrictions;
+
+-- +goose StatementEnd
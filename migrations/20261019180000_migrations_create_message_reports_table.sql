@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    IF NOT EXISTS message_reports (
+        id uuid PRIMARY KEY DEFAULT gen_random_uuid (),
+        chat_id uuid NOT NULL references chats (id) ON DELETE CASCADE,
+        message_id uuid NOT NULL,
+        message_from text,
+        reporter text NOT NULL,
+        reason text NOT NULL,
+        status text NOT NULL DEFAULT 'open',
+        resolved_by text,
+        created_at timestamptz NOT NULL DEFAULT now (),
+        resolved_at timestamptz,
+        UNIQUE (message_id, reporter)
+    );
+
+CREATE INDEX IF NOT EXISTS message_reports_chat_id_idx ON message_reports (chat_id, status, created_at);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS message_reports;
+
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    IF NOT EXISTS user_blocks (
+        blocker text NOT NULL,
+        blocked text NOT NULL,
+        created_at timestamptz NOT NULL DEFAULT now (),
+        PRIMARY KEY (blocker, blocked)
+    );
+
+CREATE INDEX IF NOT EXISTS user_blocks_blocked_idx ON user_blocks (blocked);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_blocks;
+
+-- +goose StatementEnd
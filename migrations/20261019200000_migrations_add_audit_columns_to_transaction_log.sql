@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE transaction_log
+    ALTER COLUMN timestamp TYPE timestamptz,
+    ADD COLUMN IF NOT EXISTS actor text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS action text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS chat_id uuid,
+    ADD COLUMN IF NOT EXISTS message_id uuid,
+    ADD COLUMN IF NOT EXISTS request_id text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS payload jsonb NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS transaction_log_timestamp_idx ON transaction_log (timestamp DESC, id DESC);
+CREATE INDEX IF NOT EXISTS transaction_log_actor_idx ON transaction_log (actor, timestamp DESC);
+CREATE INDEX IF NOT EXISTS transaction_log_chat_id_idx ON transaction_log (chat_id, timestamp DESC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS transaction_log_chat_id_idx;
+DROP INDEX IF EXISTS transaction_log_actor_idx;
+DROP INDEX IF EXISTS transaction_log_timestamp_idx;
+
+ALTER TABLE transaction_log
+    DROP COLUMN IF EXISTS payload,
+    DROP COLUMN IF EXISTS request_id,
+    DROP COLUMN IF EXISTS message_id,
+    DROP COLUMN IF EXISTS chat_id,
+    DROP COLUMN IF EXISTS action,
+    DROP COLUMN IF EXISTS actor,
+    ALTER COLUMN timestamp TYPE timestamp;
+
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    IF NOT EXISTS chat_keys (
+        chat_id uuid NOT NULL references chats (id) ON DELETE CASCADE,
+        version int NOT NULL,
+        wrapped_key bytea NOT NULL,
+        master_key_id text NOT NULL,
+        created_at timestamptz NOT NULL DEFAULT now (),
+        PRIMARY KEY (chat_id, version)
+    );
+
+ALTER TABLE messages
+ADD COLUMN IF NOT EXISTS key_version int;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE messages
+DROP COLUMN IF EXISTS key_version;
+
+DROP TABLE IF EXISTS chat_keys;
+
+-- +goose StatementEnd
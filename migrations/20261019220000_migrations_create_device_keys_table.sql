@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    IF NOT EXISTS device_keys (
+        id uuid PRIMARY KEY DEFAULT gen_random_uuid (),
+        username text NOT NULL,
+        device_id text NOT NULL,
+        public_key bytea NOT NULL,
+        created_at timestamptz NOT NULL DEFAULT now (),
+        revoked_at timestamptz
+    );
+
+CREATE UNIQUE INDEX IF NOT EXISTS device_keys_active_idx ON device_keys (username, device_id)
+WHERE
+    revoked_at IS NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS device_keys;
+
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE chats
+ADD COLUMN IF NOT EXISTS e2e boolean NOT NULL DEFAULT false;
+
+CREATE TABLE
+    IF NOT EXISTS message_payloads (
+        message_id uuid NOT NULL references messages (id) ON DELETE CASCADE,
+        chat_id uuid NOT NULL references chats (id) ON DELETE CASCADE,
+        recipient text NOT NULL,
+        device_id text NOT NULL,
+        ciphertext bytea NOT NULL,
+        PRIMARY KEY (message_id, recipient, device_id)
+    );
+
+CREATE INDEX IF NOT EXISTS message_payloads_chat_id_recipient_idx ON message_payloads (chat_id, recipient);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS message_payloads;
+
+ALTER TABLE chats
+DROP COLUMN IF EXISTS e2e;
+
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Chats created before roles have no known creator, so they got no owner. The first listed member
+-- becomes the owner, or the oldest member if that user left the chat. Service administrators
+-- can transfer the ownership with TransferOwnership afterwards.
+UPDATE chat_members m
+SET
+    role = 'owner'
+FROM
+    (
+        SELECT DISTINCT ON (cm.chat_id)
+            cm.chat_id,
+            cm.username
+        FROM
+            chat_members cm
+            JOIN chats c ON c.id = cm.chat_id
+        WHERE
+            NOT EXISTS (
+                SELECT
+                    1
+                FROM
+                    chat_members o
+                WHERE
+                    o.chat_id = cm.chat_id
+                    AND o.role = 'owner'
+            )
+        ORDER BY
+            cm.chat_id,
+            array_position(c.usernames, cm.username) NULLS LAST,
+            cm.created_at,
+            cm.username
+    ) successor
+WHERE
+    m.chat_id = successor.chat_id
+    AND m.username = successor.username;
+
+-- +goose StatementEnd
+-- +goose Down
+-- Backfilled owners can not be told apart from owners set later, so they are kept.